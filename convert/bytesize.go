@@ -0,0 +1,100 @@
+// Package convert provides parsing and formatting of human-readable byte sizes
+// such as "10MB" and "1.5 GiB".
+package convert
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// byteSizeUnits maps lowercased unit suffixes to their multiplier.
+// Decimal units (KB, MB, ...) use powers of 1000, binary units (KiB, MiB, ...) powers of 1024.
+var byteSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// binaryByteSizeUnits are the units used by FormatByteSize
+var binaryByteSizeUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// ParseByteSize parses a byte size such as "512", "10MB", "1.5 GiB" or "100kib".
+//
+// The suffix selects the convention: KB, MB, GB and TB are decimal (1000-based),
+// KiB, MiB, GiB and TiB are binary (1024-based). Suffixes are case-insensitive and may
+// be separated from the number by spaces; a plain number is bytes. Fractional results
+// are rounded down to whole bytes.
+//
+// Example:
+//
+//	n, err := convert.ParseByteSize("10MB")    // Returns 10000000
+//	n, err = convert.ParseByteSize("10MiB")    // Returns 10485760
+//	n, err = convert.ParseByteSize("1.5 GiB")  // Returns 1610612736
+//	n, err = convert.ParseByteSize("ten megs") // Returns error
+func ParseByteSize(s string) (int64, error) {
+	trimmed := strings.TrimSpace(s)
+	end := strings.IndexFunc(trimmed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if end < 0 {
+		end = len(trimmed)
+	}
+	number, unit := trimmed[:end], strings.ToLower(strings.TrimSpace(trimmed[end:]))
+	if number == "" {
+		return 0, fmt.Errorf("invalid byte size %q: missing number", s)
+	}
+
+	multiplier, ok := byteSizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size %q: unknown unit %q (use B, KB, MB, GB, TB, KiB, MiB, GiB or TiB)", s, trimmed[end:])
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q: %w", s, err)
+	}
+
+	size := math.Floor(value * multiplier)
+	if size >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid byte size %q: too large", s)
+	}
+	return int64(size), nil
+}
+
+// FormatByteSize formats a byte count with binary units (KiB, MiB, ...) and at most one
+// decimal, for display and logging.
+//
+// Example:
+//
+//	convert.FormatByteSize(512)        // Returns "512 B"
+//	convert.FormatByteSize(1536)       // Returns "1.5 KiB"
+//	convert.FormatByteSize(10485760)   // Returns "10 MiB"
+//	convert.FormatByteSize(1610612736) // Returns "1.5 GiB"
+func FormatByteSize(n int64) string {
+	sign := ""
+	value := float64(n)
+	if n < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	unit := 0
+	for value >= 1024 && unit < len(binaryByteSizeUnits)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%s%d %s", sign, int64(value), binaryByteSizeUnits[unit])
+	}
+
+	formatted := strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+	return sign + formatted + " " + binaryByteSizeUnits[unit]
+}
@@ -0,0 +1,88 @@
+package convert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"512", 512},
+		{"0", 0},
+		{"512B", 512},
+		{" 10 b ", 10},
+
+		// Decimal units
+		{"1KB", 1000},
+		{"10MB", 10_000_000},
+		{"2.5 GB", 2_500_000_000},
+		{"1tb", 1_000_000_000_000},
+
+		// Binary units
+		{"1KiB", 1024},
+		{"10MiB", 10 << 20},
+		{"1.5 GiB", 1610612736},
+		{"100kib", 102400},
+		{"1TiB", 1 << 40},
+
+		// Fractions round down
+		{"1.9999B", 1},
+		{"0.5KiB", 512},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseByteSize(tt.input)
+		if err != nil {
+			t.Errorf("ParseByteSize(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseByteSize(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseByteSizeErrors(t *testing.T) {
+	for _, input := range []string{"", "   ", "MB", "ten megs", "10 XB", "10 megabytes", "1.2.3MB", "-5MB", "10M B", "99999999999TB"} {
+		if n, err := ParseByteSize(input); err == nil {
+			t.Errorf("ParseByteSize(%q) = %d, want error", input, n)
+		}
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	tests := []struct {
+		input int64
+		want  string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1023, "1023 B"},
+		{1024, "1 KiB"},
+		{1536, "1.5 KiB"},
+		{1000, "1000 B"},
+		{10 << 20, "10 MiB"},
+		{1610612736, "1.5 GiB"},
+		{1 << 40, "1 TiB"},
+		{-2048, "-2 KiB"},
+		{math.MaxInt64, "8 EiB"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatByteSize(tt.input); got != tt.want {
+			t.Errorf("FormatByteSize(%d) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestByteSizeRoundTrip(t *testing.T) {
+	for _, n := range []int64{1024, 1536, 10 << 20, 3 << 30} {
+		parsed, err := ParseByteSize(FormatByteSize(n))
+		if err != nil || parsed != n {
+			t.Errorf("ParseByteSize(FormatByteSize(%d)) = %d, %v", n, parsed, err)
+		}
+	}
+}
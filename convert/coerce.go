@@ -0,0 +1,83 @@
+// Package convert provides schema-based coercion of loosely-typed maps such as
+// the dynamic request params, using the ToX converters.
+package convert
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CoerceMap converts the values of m to the types named in schema and returns a new map.
+//
+// Supported types are "int", "int64", "float", "bool", "string", "uuid" and "time".
+// Keys in m without a schema entry and nil values are copied unchanged; schema keys
+// missing from m are not added. A value that cannot be converted, or an unknown type name, is
+// reported in errs under its key and left out of the result. errs is nil when every value
+// converted.
+//
+// Example:
+//
+//	params := c.MustGet("params").(map[string]interface{})
+//	values, errs := convert.CoerceMap(params, map[string]string{
+//	    "page":      "int",
+//	    "active":    "bool",
+//	    "owner_id":  "uuid",
+//	    "from_date": "time",
+//	})
+//	if errs != nil {
+//	    helper.ErrorResponse(c, 400, "INVALID_PARAMS", fmt.Sprint(errs))
+//	    return
+//	}
+//	page := values["page"].(int)
+func CoerceMap(m map[string]interface{}, schema map[string]string) (map[string]interface{}, map[string]error) {
+	out := make(map[string]interface{}, len(m))
+	var errs map[string]error
+
+	for key, value := range m {
+		typeName, exists := schema[key]
+		if !exists || value == nil {
+			out[key] = value
+			continue
+		}
+		coerced, err := coerceValue(value, typeName)
+		if err != nil {
+			if errs == nil {
+				errs = make(map[string]error)
+			}
+			errs[key] = err
+			continue
+		}
+		out[key] = coerced
+	}
+
+	return out, errs
+}
+
+// coerceValue converts value to the schema type typeName
+func coerceValue(value interface{}, typeName string) (interface{}, error) {
+	switch typeName {
+	case "int":
+		return ToInt(value)
+	case "int64":
+		return ToInt64(value)
+	case "float":
+		return ToFloat64(value)
+	case "bool":
+		return ToBoolStrict(value)
+	case "string":
+		return ToString(value), nil
+	case "uuid":
+		if id, ok := value.(uuid.UUID); ok {
+			return id, nil
+		}
+		id, err := uuid.Parse(ToString(value))
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %v to uuid: %w", value, err)
+		}
+		return id, nil
+	case "time":
+		return ToTime(value)
+	}
+	return nil, fmt.Errorf("unknown schema type: %s", typeName)
+}
@@ -0,0 +1,93 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCoerceMapMixedFields(t *testing.T) {
+	ownerID := uuid.New()
+	params := map[string]interface{}{
+		"page":      "2",
+		"limit":     float64(50),
+		"total":     "9007199254740993",
+		"price":     "19.5",
+		"active":    "yes",
+		"name":      42,
+		"owner_id":  ownerID.String(),
+		"from_date": "2026-01-02",
+		"untyped":   "kept",
+		"optional":  nil,
+
+		// Invalid values
+		"count":     "abc",
+		"enabled":   "maybe",
+		"parent_id": "not-a-uuid",
+		"to_date":   "someday",
+		"weird":     "x",
+	}
+	schema := map[string]string{
+		"page":      "int",
+		"limit":     "int",
+		"total":     "int64",
+		"price":     "float",
+		"active":    "bool",
+		"name":      "string",
+		"owner_id":  "uuid",
+		"from_date": "time",
+		"optional":  "int",
+		"missing":   "int",
+		"count":     "int",
+		"enabled":   "bool",
+		"parent_id": "uuid",
+		"to_date":   "time",
+		"weird":     "decimal",
+	}
+
+	values, errs := CoerceMap(params, schema)
+
+	want := map[string]interface{}{
+		"page":      2,
+		"limit":     50,
+		"total":     int64(9007199254740993),
+		"price":     19.5,
+		"active":    true,
+		"name":      "42",
+		"owner_id":  ownerID,
+		"from_date": time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		"untyped":   "kept",
+		"optional":  nil,
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("values = %#v\nwant %#v", values, want)
+	}
+
+	wantErrs := []string{"count", "enabled", "parent_id", "to_date", "weird"}
+	if len(errs) != len(wantErrs) {
+		t.Errorf("errs = %v, want errors for %v", errs, wantErrs)
+	}
+	for _, key := range wantErrs {
+		if errs[key] == nil {
+			t.Errorf("missing error for %q", key)
+		}
+		if _, ok := values[key]; ok {
+			t.Errorf("invalid %q must be left out of the result", key)
+		}
+	}
+	if _, ok := values["missing"]; ok {
+		t.Error("schema keys missing from the map must not be added")
+	}
+}
+
+func TestCoerceMapAllValid(t *testing.T) {
+	values, errs := CoerceMap(map[string]interface{}{"id": "7"}, map[string]string{"id": "int"})
+	if errs != nil {
+		t.Errorf("errs = %v, want nil", errs)
+	}
+	if values["id"] != 7 {
+		t.Errorf("id = %#v, want 7", values["id"])
+	}
+}
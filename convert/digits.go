@@ -0,0 +1,45 @@
+// Package convert provides digit normalization so numbers typed with Thai or
+// Arabic-Indic numerals parse like ASCII input.
+package convert
+
+import "strings"
+
+// NormalizeNumberStrings controls whether ToInt, ToInt64, ToFloat64 and StringToIntSlice
+// run string input through NormalizeDigits before parsing (default true).
+// Input that already parsed is unaffected; only non-ASCII digits are mapped.
+var NormalizeNumberStrings = true
+
+// digitRanges lists the zero digit of each numeral system mapped by NormalizeDigits
+var digitRanges = []rune{
+	'๐', // Thai ๐-๙
+	'٠', // Arabic-Indic ٠-٩
+	'۰', // Extended Arabic-Indic (Persian, Urdu) ۰-۹
+}
+
+// NormalizeDigits replaces Thai and Arabic-Indic digits in s with ASCII 0-9.
+// All other characters are kept, so mixed strings work and the result can be
+// passed to strconv.
+//
+// Example:
+//
+//	convert.NormalizeDigits("๑๒๓")         // Returns "123"
+//	convert.NormalizeDigits("ราคา ๑,๒๕0 บาท") // Returns "ราคา 1,250 บาท"
+//	convert.NormalizeDigits("٣.١٤")        // Returns "3.14"
+func NormalizeDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		for _, zero := range digitRanges {
+			if r >= zero && r <= zero+9 {
+				return '0' + (r - zero)
+			}
+		}
+		return r
+	}, s)
+}
+
+// numberString prepares string input for parsing according to NormalizeNumberStrings
+func numberString(s string) string {
+	if NormalizeNumberStrings {
+		return NormalizeDigits(s)
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeDigits(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"๑๒๓", "123"},
+		{"๐๑๒๓๔๕๖๗๘๙", "0123456789"},
+		{"1๒3", "123"},
+		{"๑2๓4", "1234"},
+		{"ราคา ๑,๒๕0 บาท", "ราคา 1,250 บาท"},
+		{"٣.١٤", "3.14"},
+		{"۱۲۳", "123"},
+		{"0812345678", "0812345678"},
+		{"ไม่มีตัวเลข", "ไม่มีตัวเลข"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeDigits(tt.input); got != tt.want {
+			t.Errorf("NormalizeDigits(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestConvertersAcceptThaiDigits(t *testing.T) {
+	if got, err := ToInt("๑๒๓"); err != nil || got != 123 {
+		t.Errorf("ToInt(๑๒๓) = %d, %v", got, err)
+	}
+	if got, err := ToInt("-๔2"); err != nil || got != -42 {
+		t.Errorf("ToInt(-๔2) = %d, %v", got, err)
+	}
+	if got, err := ToInt64("๙๐๐๗๑๙๙๒๕๔๗๔๐๙๙๓"); err != nil || got != 9007199254740993 {
+		t.Errorf("ToInt64() = %d, %v", got, err)
+	}
+	if got, err := ToFloat64("๓.๑๔"); err != nil || got != 3.14 {
+		t.Errorf("ToFloat64(๓.๑๔) = %v, %v", got, err)
+	}
+	if got, err := StringToIntSlice("๑, 2,๓๔", ","); err != nil || !reflect.DeepEqual(got, []int{1, 2, 34}) {
+		t.Errorf("StringToIntSlice() = %v, %v", got, err)
+	}
+	if _, err := ToInt("๑๒x"); err == nil {
+		t.Error("ToInt(๑๒x) must still fail")
+	}
+}
+
+func TestNormalizeNumberStringsDisabled(t *testing.T) {
+	NormalizeNumberStrings = false
+	defer func() { NormalizeNumberStrings = true }()
+
+	if _, err := ToInt("๑๒๓"); err == nil {
+		t.Error("ToInt(๑๒๓) must fail when NormalizeNumberStrings is false")
+	}
+	if got, err := ToInt("123"); err != nil || got != 123 {
+		t.Errorf("ToInt(123) = %d, %v", got, err)
+	}
+}
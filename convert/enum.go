@@ -4,7 +4,9 @@
 package convert
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"reflect"
 	"strings"
 )
 
@@ -194,6 +196,26 @@ func ValidateEnum(value string, validValues []string) (string, error) {
 	return "", fmt.Errorf("invalid enum value: %s, valid values: %v", value, validValues)
 }
 
+// CanonicalizeEnum matches a value case-insensitively (and ignoring surrounding whitespace)
+// against the valid enum values and returns the matching valid value in its canonical casing.
+// Use it to accept user input loosely but store it exactly as defined.
+//
+// Example:
+//
+//	validStatuses := []string{"Active", "Inactive", "Pending"}
+//	status, err := convert.CanonicalizeEnum("  ACTIVE ", validStatuses) // Returns "Active"
+//	status, err = convert.CanonicalizeEnum("pending", validStatuses)    // Returns "Pending"
+//	status, err = convert.CanonicalizeEnum("deleted", validStatuses)    // Returns error with valid values
+func CanonicalizeEnum(value string, validValues []string) (string, error) {
+	normalized := NormalizeEnumString(value)
+	for _, valid := range validValues {
+		if NormalizeEnumString(valid) == normalized {
+			return valid, nil
+		}
+	}
+	return "", fmt.Errorf("invalid enum value: %s, valid values: %v", value, validValues)
+}
+
 // ValidateEnumInt checks if an integer value exists in the list of valid enum integers.
 // Returns the validated value if found, otherwise returns an error with all valid values.
 //
@@ -210,3 +232,149 @@ func ValidateEnumInt(value int, validValues []int) (int, error) {
 	}
 	return 0, fmt.Errorf("invalid enum value: %d, valid values: %v", value, validValues)
 }
+
+// EnumValuer is the constraint for enum types stored in the database with ScanEnum and ValueEnum.
+type EnumValuer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~string
+}
+
+// ScanEnum converts a database value into an enum and validates it against the allowed set.
+// Returns an error for values not in valid, so unknown DB values are never silently accepted.
+// Implement sql.Scanner on an enum type by calling it from Scan.
+//
+// Example:
+//
+//	type Status string
+//
+//	const (
+//	    StatusActive   Status = "ACTIVE"
+//	    StatusInactive Status = "INACTIVE"
+//	)
+//
+//	var validStatuses = []Status{StatusActive, StatusInactive}
+//
+//	func (s *Status) Scan(src interface{}) error {
+//	    return convert.ScanEnum(s, src, validStatuses)
+//	}
+//
+//	func (s Status) Value() (driver.Value, error) {
+//	    return convert.ValueEnum(s)
+//	}
+func ScanEnum[T EnumValuer](dest *T, src interface{}, valid []T) error {
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+
+	var value T
+	rv := reflect.ValueOf(&value).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(ToString(src))
+	} else {
+		n, err := ToInt64(src)
+		if err != nil {
+			return fmt.Errorf("cannot scan %T into %T: %w", src, value, err)
+		}
+		if rv.OverflowInt(n) {
+			return fmt.Errorf("enum value %d overflows %T", n, value)
+		}
+		rv.SetInt(n)
+	}
+
+	for _, v := range valid {
+		if v == value {
+			*dest = value
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid enum value: %v, valid values: %v", value, valid)
+}
+
+// ValueEnum converts an enum into a database value (int64 or string).
+// See ScanEnum for a complete example.
+func ValueEnum[T EnumValuer](v T) (driver.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	return rv.Int(), nil
+}
+
+// EnumPair is one key/value entry of an OrderedEnum.
+type EnumPair[V any] struct {
+	Key   string
+	Value V
+}
+
+// OrderedEnum is an enum mapping that keeps its declaration order, for ordered lists
+// such as UI dropdowns. Use the map-based helpers when only lookup is needed.
+// An OrderedEnum is read-only after creation and safe for concurrent use.
+//
+// Example:
+//
+//	var Priorities = convert.NewOrderedEnum(
+//	    convert.EnumPair[int]{Key: "LOW", Value: 1},
+//	    convert.EnumPair[int]{Key: "MEDIUM", Value: 2},
+//	    convert.EnumPair[int]{Key: "HIGH", Value: 3},
+//	)
+//	keys := Priorities.Keys()                // Returns ["LOW", "MEDIUM", "HIGH"]
+//	value, err := Priorities.Match(" high ") // Returns 3
+type OrderedEnum[V any] struct {
+	pairs []EnumPair[V]
+}
+
+// NewOrderedEnum creates an OrderedEnum from pairs in the given order.
+// For duplicate keys the first pair wins in Match and Validate.
+func NewOrderedEnum[V any](pairs ...EnumPair[V]) *OrderedEnum[V] {
+	return &OrderedEnum[V]{pairs: append([]EnumPair[V](nil), pairs...)}
+}
+
+// Pairs returns a copy of the key/value pairs in declaration order.
+func (e *OrderedEnum[V]) Pairs() []EnumPair[V] {
+	return append([]EnumPair[V](nil), e.pairs...)
+}
+
+// Keys returns the keys in declaration order.
+func (e *OrderedEnum[V]) Keys() []string {
+	keys := make([]string, len(e.pairs))
+	for i, pair := range e.pairs {
+		keys[i] = pair.Key
+	}
+	return keys
+}
+
+// Values returns the values in declaration order.
+func (e *OrderedEnum[V]) Values() []V {
+	values := make([]V, len(e.pairs))
+	for i, pair := range e.pairs {
+		values[i] = pair.Value
+	}
+	return values
+}
+
+// Match returns the value of the key matching str case-insensitively, like EnumMatch.
+//
+// Example:
+//
+//	value, err := Priorities.Match("medium") // Returns 2
+//	value, err = Priorities.Match("urgent")  // Returns error
+func (e *OrderedEnum[V]) Match(str string) (V, error) {
+	normalized := NormalizeEnumString(str)
+	for _, pair := range e.pairs {
+		if NormalizeEnumString(pair.Key) == normalized {
+			return pair.Value, nil
+		}
+	}
+	var zero V
+	return zero, fmt.Errorf("invalid enum value: %s", str)
+}
+
+// Validate checks that key exists exactly, like ValidateEnum.
+// Returns the key if found, otherwise an error listing the valid keys in order.
+//
+// Example:
+//
+//	key, err := Priorities.Validate("HIGH") // Returns "HIGH"
+//	key, err = Priorities.Validate("high")  // Returns error with valid values
+func (e *OrderedEnum[V]) Validate(key string) (string, error) {
+	return ValidateEnum(key, e.Keys())
+}
@@ -4,8 +4,14 @@
 package convert
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // ToJSON converts any Go value to a JSON string representation.
@@ -54,6 +60,75 @@ func ToJSONIndent(value interface{}, prefix, indent string) (string, error) {
 	return string(bytes), nil
 }
 
+// ToJSONOmitZero converts a value to JSON like ToJSON, but removes object keys whose values
+// are null, false, 0, "", {} or [] at runtime, for compact responses where struct tags
+// cannot be changed per endpoint. Nested objects are cleaned recursively (and removed when
+// they end up empty); array elements are kept so indexes do not shift, but objects inside
+// arrays are cleaned.
+//
+// Cost: the value is marshaled, decoded and marshaled again, so it is roughly three times
+// slower than ToJSON. Object keys come out in alphabetical order. Zero values that do not
+// marshal to one of the values above (e.g. a zero time.Time) are kept.
+//
+// Example:
+//
+//	user := User{Name: "John", Age: 0, Address: Address{City: ""}}
+//	jsonStr, err := convert.ToJSONOmitZero(user)
+//	// Returns: {"name":"John"}
+func ToJSONOmitZero(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	decoded, err := FromJSONNumber(string(data))
+	if err != nil {
+		return "", err
+	}
+	return ToJSON(omitZeroJSON(decoded))
+}
+
+// omitZeroJSON removes zero values from decoded JSON objects
+func omitZeroJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			cleaned := omitZeroJSON(item)
+			if isZeroJSON(cleaned) {
+				delete(v, key)
+				continue
+			}
+			v[key] = cleaned
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = omitZeroJSON(item)
+		}
+		return v
+	}
+	return value
+}
+
+// isZeroJSON reports null, false, 0, "", {} and [] decoded values
+func isZeroJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
 // FromJSON parses a JSON string and returns the result as interface{}.
 // The result can be a map, slice, or primitive value depending on the JSON structure.
 //
@@ -76,6 +151,32 @@ func FromJSON(jsonStr string) (interface{}, error) {
 	return result, nil
 }
 
+// FromJSONNumber parses a JSON string like FromJSON, but decodes numbers as json.Number
+// instead of float64, preserving their original text. Use it when numeric IDs larger than
+// 2^53 must survive intact; ToInt64, ToInt, ToFloat64 and ToString accept json.Number.
+//
+// Example:
+//
+//	result, err := convert.FromJSONNumber(`{"id":9007199254740993}`)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	data := result.(map[string]interface{})
+//	id, _ := convert.ToInt64(data["id"]) // 9007199254740993 (FromJSON would give ...992)
+func FromJSONNumber(jsonStr string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(jsonStr))
+	decoder.UseNumber()
+
+	var result interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid character after top-level value")
+	}
+	return result, nil
+}
+
 // FromJSONTo parses a JSON string into a specific target struct or type.
 // The target parameter must be a pointer to the destination variable.
 //
@@ -209,3 +310,114 @@ func IsValidJSON(jsonStr string) bool {
 	var js interface{}
 	return json.Unmarshal([]byte(jsonStr), &js) == nil
 }
+
+// ToCanonicalJSON marshals a value to canonical JSON: byte-identical output for equal data.
+// Use it for HMAC signatures, ETags and cache keys computed over JSON.
+//
+// Object keys are sorted at every level, there is no insignificant whitespace, HTML
+// characters are not escaped, and numbers are normalized (1.0, 1 and 1e0 all become 1;
+// integers up to ±2^63 keep full precision, other numbers use the shortest float64 form).
+//
+// Example:
+//
+//	a, _ := convert.ToCanonicalJSON(map[string]interface{}{"b": 1.0, "a": []int{2, 1}})
+//	b, _ := convert.ToCanonicalJSON(map[string]interface{}{"a": []int{2, 1}, "b": 1})
+//	// Both return: {"a":[2,1],"b":1}
+//	signature := helper.SignHMACSHA256(secret, a)
+func ToCanonicalJSON(value interface{}) ([]byte, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, decoded); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalJSON writes a decoded JSON value in canonical form
+func writeCanonicalJSON(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		num, err := canonicalJSONNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(num)
+	case string:
+		writeCanonicalJSONString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalJSONString(buf, key)
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value %T", value)
+	}
+	return nil
+}
+
+// writeCanonicalJSONString writes a JSON string without HTML escaping
+func writeCanonicalJSONString(buf *bytes.Buffer, s string) {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s) // encoding a string cannot fail
+	buf.Truncate(buf.Len() - 1)
+}
+
+// canonicalJSONNumber normalizes a JSON number literal
+func canonicalJSONNumber(n json.Number) (string, error) {
+	s := n.String()
+	if !strings.ContainsAny(s, ".eE") {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return strconv.FormatInt(i, 10), nil
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", err
+	}
+	if f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+		return strconv.FormatInt(int64(f), 10), nil
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
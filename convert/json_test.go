@@ -0,0 +1,73 @@
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestToCanonicalJSONEqualMaps(t *testing.T) {
+	a := map[string]interface{}{}
+	b := map[string]interface{}{}
+	keys := []string{"zeta", "alpha", "mid", "beta", "omega", "gamma"}
+	for i, key := range keys {
+		a[key] = map[string]interface{}{"n": i, "tags": []string{"x", "y"}, key: true}
+	}
+	for i := len(keys) - 1; i >= 0; i-- {
+		b[keys[i]] = map[string]interface{}{keys[i]: true, "tags": []string{"x", "y"}, "n": float64(i)}
+	}
+
+	first, err := ToCanonicalJSON(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		got, err := ToCanonicalJSON(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, first) {
+			t.Fatalf("equal maps gave different bytes:\n%s\n%s", first, got)
+		}
+	}
+}
+
+func TestToCanonicalJSONOutput(t *testing.T) {
+	type payload struct {
+		Zip   string  `json:"zip"`
+		Name  string  `json:"name"`
+		Total float64 `json:"total"`
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{"sorted keys", map[string]interface{}{"b": 1, "a": map[string]int{"d": 4, "c": 3}}, `{"a":{"c":3,"d":4},"b":1}`},
+		{"struct fields sorted", payload{Zip: "10110", Name: "A", Total: 2.50}, `{"name":"A","total":2.5,"zip":"10110"}`},
+		{"numbers normalized", []interface{}{1.0, 1, json.Number("1e0"), json.Number("1.50")}, `[1,1,1,1.5]`},
+		{"int64 precision", map[string]int64{"id": 9007199254740993}, `{"id":9007199254740993}`},
+		{"html not escaped", map[string]string{"q": "<a&b>"}, `{"q":"<a&b>"}`},
+		{"arrays keep order", []int{3, 1, 2}, `[3,1,2]`},
+		{"null and bool", map[string]interface{}{"x": nil, "y": false}, `{"x":null,"y":false}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToCanonicalJSON(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ToCanonicalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToCanonicalJSONUnsupported(t *testing.T) {
+	if _, err := ToCanonicalJSON(map[string]interface{}{"ch": make(chan int)}); err == nil {
+		t.Error("expected an error for a channel value")
+	}
+}
@@ -0,0 +1,64 @@
+// Package convert provides Must variants of the conversion functions for
+// initialization code, where a failed conversion is a programming error.
+package convert
+
+import "time"
+
+// Must returns v, or panics if err is not nil.
+//
+// Use it only for values the program controls (constants, package variables, tests).
+// Never use it on user input: a bad request would crash the handler instead of
+// returning an error.
+//
+// Example:
+//
+//	var defaultExpiry = convert.Must(time.ParseDuration("15m"))
+//	var limits = convert.Must(convert.StructToMap(defaultLimits))
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustToInt is ToInt that panics on error. Not for user input.
+//
+// Example:
+//
+//	port := convert.MustToInt(os.Getenv("PORT"))
+func MustToInt(value interface{}) int {
+	return Must(ToInt(value))
+}
+
+// MustToInt64 is ToInt64 that panics on error. Not for user input.
+func MustToInt64(value interface{}) int64 {
+	return Must(ToInt64(value))
+}
+
+// MustToFloat64 is ToFloat64 that panics on error. Not for user input.
+func MustToFloat64(value interface{}) float64 {
+	return Must(ToFloat64(value))
+}
+
+// MustToBoolStrict is ToBoolStrict that panics on error. Not for user input.
+func MustToBoolStrict(value interface{}) bool {
+	return Must(ToBoolStrict(value))
+}
+
+// MustToTime is ToTime that panics on error. Not for user input.
+//
+// Example:
+//
+//	var launchDate = convert.MustToTime("2026-01-01")
+func MustToTime(value interface{}, layouts ...string) time.Time {
+	return Must(ToTime(value, layouts...))
+}
+
+// MustToJSON is ToJSON that panics on error. Not for user input.
+//
+// Example:
+//
+//	var schema = convert.MustToJSON(map[string]interface{}{"type": "object"})
+func MustToJSON(value interface{}) string {
+	return Must(ToJSON(value))
+}
@@ -0,0 +1,61 @@
+package convert
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// mustPanic fails the test unless fn panics
+func mustPanic(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s did not panic", name)
+		}
+	}()
+	fn()
+}
+
+func TestMust(t *testing.T) {
+	if got := Must(42, nil); got != 42 {
+		t.Errorf("Must(42, nil) = %d", got)
+	}
+
+	errBoom := errors.New("boom")
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("recovered %v, want the original error", r)
+		}
+	}()
+	Must(0, errBoom)
+	t.Error("Must did not panic")
+}
+
+func TestMustWrappers(t *testing.T) {
+	if got := MustToInt("8080"); got != 8080 {
+		t.Errorf("MustToInt = %d", got)
+	}
+	if got := MustToInt64("9007199254740993"); got != 9007199254740993 {
+		t.Errorf("MustToInt64 = %d", got)
+	}
+	if got := MustToFloat64("1.5"); got != 1.5 {
+		t.Errorf("MustToFloat64 = %v", got)
+	}
+	if !MustToBoolStrict("yes") {
+		t.Error("MustToBoolStrict(yes) = false")
+	}
+	if got := MustToTime("2026-01-01"); !got.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("MustToTime = %v", got)
+	}
+	if got := MustToJSON(map[string]int{"a": 1}); got != `{"a":1}` {
+		t.Errorf("MustToJSON = %s", got)
+	}
+
+	mustPanic(t, "MustToInt", func() { MustToInt("abc") })
+	mustPanic(t, "MustToInt64", func() { MustToInt64("abc") })
+	mustPanic(t, "MustToFloat64", func() { MustToFloat64("abc") })
+	mustPanic(t, "MustToBoolStrict", func() { MustToBoolStrict("maybe") })
+	mustPanic(t, "MustToTime", func() { MustToTime("not a date") })
+	mustPanic(t, "MustToJSON", func() { MustToJSON(make(chan int)) })
+}
@@ -0,0 +1,170 @@
+// Package convert provides utilities for converting between database/sql null
+// types and plain Go values for JSON serialization.
+package convert
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// FromNullString returns the string value, or nil when ns is NULL.
+//
+// Example:
+//
+//	response["nickname"] = convert.FromNullString(user.Nickname) // "john" or nil
+func FromNullString(ns sql.NullString) interface{} {
+	if !ns.Valid {
+		return nil
+	}
+	return ns.String
+}
+
+// FromNullInt64 returns the int64 value, or nil when n is NULL.
+func FromNullInt64(n sql.NullInt64) interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Int64
+}
+
+// FromNullFloat64 returns the float64 value, or nil when n is NULL.
+func FromNullFloat64(n sql.NullFloat64) interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Float64
+}
+
+// FromNullBool returns the bool value, or nil when n is NULL.
+func FromNullBool(n sql.NullBool) interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Bool
+}
+
+// FromNullTime returns the time value, or nil when n is NULL.
+func FromNullTime(n sql.NullTime) interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Time
+}
+
+// ToNullString converts a value to sql.NullString. Nil (and nil pointers) become NULL;
+// other values are converted with ToString.
+//
+// Example:
+//
+//	user.Nickname = convert.ToNullString(params["nickname"])
+func ToNullString(v interface{}) sql.NullString {
+	if v = derefNullable(v); v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: ToString(v), Valid: true}
+}
+
+// ToNullInt64 converts a value to sql.NullInt64. Nil and unconvertible values become NULL.
+func ToNullInt64(v interface{}) sql.NullInt64 {
+	if v = derefNullable(v); v == nil {
+		return sql.NullInt64{}
+	}
+	n, err := ToInt64(v)
+	if err != nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: n, Valid: true}
+}
+
+// ToNullFloat64 converts a value to sql.NullFloat64. Nil and unconvertible values become NULL.
+func ToNullFloat64(v interface{}) sql.NullFloat64 {
+	if v = derefNullable(v); v == nil {
+		return sql.NullFloat64{}
+	}
+	f, err := ToFloat64(v)
+	if err != nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: f, Valid: true}
+}
+
+// ToNullBool converts a value to sql.NullBool. Nil and unrecognized values become NULL.
+func ToNullBool(v interface{}) sql.NullBool {
+	if v = derefNullable(v); v == nil {
+		return sql.NullBool{}
+	}
+	b, err := ToBoolStrict(v)
+	if err != nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: b, Valid: true}
+}
+
+// ToNullTime converts a value to sql.NullTime using ToTime. Nil, zero and unparseable values become NULL.
+func ToNullTime(v interface{}) sql.NullTime {
+	if v = derefNullable(v); v == nil {
+		return sql.NullTime{}
+	}
+	t, err := ToTime(v)
+	if err != nil || t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
+
+// NullableToJSON marshals a sql null type (or any driver.Valuer) as null or its value,
+// instead of the {"String":"x","Valid":true} object json.Marshal produces.
+//
+// Example:
+//
+//	data, _ := convert.NullableToJSON(sql.NullString{String: "john", Valid: true}) // "john"
+//	data, _ = convert.NullableToJSON(sql.NullInt64{})                             // null
+func NullableToJSON(v driver.Valuer) ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	value, err := v.Value()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// derefNullable unwraps pointers, returning nil for nil pointers
+func derefNullable(v interface{}) interface{} {
+	switch p := v.(type) {
+	case *string:
+		if p == nil {
+			return nil
+		}
+		return *p
+	case *int:
+		if p == nil {
+			return nil
+		}
+		return *p
+	case *int64:
+		if p == nil {
+			return nil
+		}
+		return *p
+	case *float64:
+		if p == nil {
+			return nil
+		}
+		return *p
+	case *bool:
+		if p == nil {
+			return nil
+		}
+		return *p
+	case *time.Time:
+		if p == nil {
+			return nil
+		}
+		return *p
+	}
+	return v
+}
@@ -0,0 +1,29 @@
+// Package convert provides generic helpers for working with pointers,
+// such as optional fields in partial-update structs.
+package convert
+
+// Ptr returns a pointer to a copy of v.
+// Useful for setting optional pointer fields from literals.
+//
+// Example:
+//
+//	update := UpdateUser{
+//	    Name:   convert.Ptr("John"),
+//	    Active: convert.Ptr(false),
+//	}
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value p points to, or def if p is nil.
+//
+// Example:
+//
+//	name := convert.Deref(update.Name, "")     // Returns "John"
+//	age := convert.Deref(update.Age, 18)       // Returns 18 when Age is nil
+func Deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
@@ -0,0 +1,45 @@
+package convert
+
+import "testing"
+
+func TestPtr(t *testing.T) {
+	v := 42
+	p := Ptr(v)
+	if p == nil || *p != 42 {
+		t.Fatalf("Ptr(42) = %v", p)
+	}
+	*p = 7
+	if v != 42 {
+		t.Error("Ptr must point to a copy")
+	}
+	if Ptr(v) == Ptr(v) {
+		t.Error("each call must return a new pointer")
+	}
+	if s := Ptr(""); s == nil || *s != "" {
+		t.Error("Ptr of a zero value must not be nil")
+	}
+}
+
+func TestDeref(t *testing.T) {
+	if got := Deref(Ptr("John"), "default"); got != "John" {
+		t.Errorf("Deref(&John) = %q", got)
+	}
+	if got := Deref(Ptr(0), 18); got != 0 {
+		t.Errorf("Deref(&0) = %d, want 0 (the zero value is not the default)", got)
+	}
+
+	var nilInt *int
+	if got := Deref(nilInt, 18); got != 18 {
+		t.Errorf("Deref(nil, 18) = %d", got)
+	}
+	var nilString *string
+	if got := Deref(nilString, ""); got != "" {
+		t.Errorf("Deref(nil, \"\") = %q", got)
+	}
+
+	type user struct{ Name string }
+	var nilUser *user
+	if got := Deref(nilUser, user{Name: "guest"}); got.Name != "guest" {
+		t.Errorf("Deref(nil struct) = %+v", got)
+	}
+}
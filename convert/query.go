@@ -0,0 +1,191 @@
+// Package convert provides utilities for converting structs into URL query
+// parameters for building outbound HTTP requests.
+package convert
+
+import (
+	"encoding"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// StructToQueryValues converts a struct into url.Values.
+//
+// Parameter names come from the `url` tag, then the `json` tag, then the field name.
+// A tag of "-" skips the field, and the "omitempty" option skips zero values.
+// Slice and array fields become repeated parameters, time.Time is formatted as RFC3339,
+// encoding.TextMarshaler values (e.g. uuid.UUID) use their text form, and embedded
+// structs are flattened. Nil pointers are skipped.
+//
+// Example:
+//
+//	type ListUsers struct {
+//	    Query  string   `url:"q,omitempty"`
+//	    Page   int      `url:"page"`
+//	    Status []string `url:"status"`
+//	}
+//	values, err := convert.StructToQueryValues(ListUsers{Page: 2, Status: []string{"active", "new"}})
+//	// Returns: page=2&status=active&status=new
+func StructToQueryValues(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return url.Values{}, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("value must be a struct or pointer to struct")
+	}
+
+	values := url.Values{}
+	if err := addQueryFields(values, rv); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// StructToQueryString converts a struct into an encoded query string (sorted by key).
+//
+// Example:
+//
+//	query, err := convert.StructToQueryString(ListUsers{Query: "john", Page: 1})
+//	// Returns: "page=1&q=john"
+//	resp, err := http.Get("https://api.example.com/users?" + query)
+func StructToQueryString(v interface{}) (string, error) {
+	values, err := StructToQueryValues(v)
+	if err != nil {
+		return "", err
+	}
+	return values.Encode(), nil
+}
+
+// addQueryFields adds the exported fields of a struct value to values
+func addQueryFields(values url.Values, rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := rv.Field(i)
+
+		name, omitEmpty := queryFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct && !isQueryScalar(fv) {
+				if err := addQueryFields(values, fv); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() || !fv.CanInterface() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		for fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+			if fv.IsNil() {
+				break
+			}
+			fv = fv.Elem()
+		}
+		if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface) && fv.IsNil() {
+			continue
+		}
+
+		if (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array) && !isQueryScalar(fv) {
+			for j := 0; j < fv.Len(); j++ {
+				s, err := queryValueString(fv.Index(j))
+				if err != nil {
+					return err
+				}
+				values.Add(name, s)
+			}
+			continue
+		}
+
+		s, err := queryValueString(fv)
+		if err != nil {
+			return err
+		}
+		values.Add(name, s)
+	}
+	return nil
+}
+
+// queryFieldName returns the tag name and omitempty option for a struct field
+func queryFieldName(field reflect.StructField) (string, bool) {
+	for _, key := range []string{"url", "json"} {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		return name, strings.Contains(","+opts+",", ",omitempty,")
+	}
+	return "", false
+}
+
+// isQueryScalar reports whether a value is formatted as a single parameter
+// even though its kind is a struct, slice or array (time.Time, uuid.UUID, []byte)
+func isQueryScalar(rv reflect.Value) bool {
+	t := rv.Type()
+	if t == timeType {
+		return true
+	}
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+		return true
+	}
+	return t.Implements(textMarshalerType)
+}
+
+// queryValueString formats a single value as a query parameter
+func queryValueString(rv reflect.Value) (string, error) {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return "", nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch v := rv.Interface().(type) {
+	case time.Time:
+		return v.Format(time.RFC3339), nil
+	case []byte:
+		return string(v), nil
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Func, reflect.Chan:
+		return "", errors.New("unsupported query value type " + rv.Type().String())
+	}
+	return ToString(rv.Interface()), nil
+}
@@ -0,0 +1,197 @@
+// Package convert provides utilities for marshaling structs to JSON with
+// tagged fields masked, for logging values that contain secrets.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// RedactMask replaces the values of redacted fields in ToJSONRedacted output.
+const RedactMask = "***"
+
+// jsonMarshalerType detects types with their own JSON encoding
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// ToJSONRedacted converts a value to JSON like ToJSON, but fields tagged `redact:"true"`
+// or `log:"-"` are emitted with the value "***".
+//
+// Unlike `json:"-"` the field is kept, so the shape stays stable for log parsers.
+// Field names, omitempty and embedded structs follow the json tags. Nested structs,
+// pointers, maps and slices of structs are redacted recursively; types with their own
+// MarshalJSON or MarshalText (e.g. time.Time) are marshaled as usual.
+//
+// Example:
+//
+//	type Credentials struct {
+//	    Username string `json:"username"`
+//	    Password string `json:"password" redact:"true"`
+//	}
+//	type Request struct {
+//	    Creds  Credentials   `json:"creds"`
+//	    Tokens []Credentials `json:"tokens"`
+//	    APIKey string        `json:"api_key" log:"-"`
+//	}
+//	jsonStr, _ := convert.ToJSONRedacted(req)
+//	// {"creds":{"username":"john","password":"***"},"tokens":[...],"api_key":"***"}
+func ToJSONRedacted(value interface{}) (string, error) {
+	data, err := json.Marshal(redactValue(reflect.ValueOf(value)))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// redactedField is one key of a redactedObject
+type redactedField struct {
+	key   string
+	value interface{}
+}
+
+// redactedObject is a JSON object that keeps struct field order
+type redactedObject []redactedField
+
+// MarshalJSON writes the fields in order
+func (o redactedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// redactValue converts v into a value that marshals with redacted fields masked
+func redactValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return redactValue(v.Elem())
+	case reflect.Struct:
+		if reflect.PointerTo(v.Type()).Implements(jsonMarshalerType) || reflect.PointerTo(v.Type()).Implements(textMarshalerType) {
+			copied := reflect.New(v.Type())
+			copied.Elem().Set(v)
+			return copied.Interface()
+		}
+		return redactStruct(v, nil)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		out := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = redactValue(iter.Value())
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		out := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out[i] = redactValue(v.Index(i))
+		}
+		return out
+	}
+	return v.Interface()
+}
+
+// redactStruct converts the exported fields of a struct, flattening embedded structs into obj
+func redactStruct(v reflect.Value, obj redactedObject) redactedObject {
+	if obj == nil {
+		obj = redactedObject{}
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		fv := v.Field(i)
+
+		if field.Anonymous && name == "" {
+			embedded := fv
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				obj = redactStruct(embedded, obj)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.Contains(opts, "omitempty") && isEmptyJSONValue(fv) {
+			continue
+		}
+
+		if isRedactedField(field) {
+			obj = append(obj, redactedField{key: name, value: RedactMask})
+			continue
+		}
+		obj = append(obj, redactedField{key: name, value: redactValue(fv)})
+	}
+	return obj
+}
+
+// isEmptyJSONValue matches the omitempty rules of encoding/json
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Ptr:
+		return v.IsZero()
+	}
+	return false
+}
+
+// isRedactedField checks the redact and log struct tags
+func isRedactedField(field reflect.StructField) bool {
+	return field.Tag.Get("redact") == "true" || field.Tag.Get("log") == "-"
+}
@@ -0,0 +1,81 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type redactCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password" redact:"true"`
+}
+
+type redactAudit struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type redactRequest struct {
+	redactAudit
+	Creds    redactCredentials            `json:"creds"`
+	Tokens   []redactCredentials          `json:"tokens"`
+	Backup   *redactCredentials           `json:"backup,omitempty"`
+	ByName   map[string]redactCredentials `json:"by_name"`
+	APIKey   string                       `json:"api_key" log:"-"`
+	Internal string                       `json:"-"`
+	Note     string                       `json:"note,omitempty"`
+	Secret   string                       `redact:"true"`
+}
+
+func TestToJSONRedactedNested(t *testing.T) {
+	req := redactRequest{
+		redactAudit: redactAudit{CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Creds:       redactCredentials{Username: "john", Password: "p1"},
+		Tokens: []redactCredentials{
+			{Username: "a", Password: "p2"},
+			{Username: "b", Password: "p3"},
+		},
+		ByName:   map[string]redactCredentials{"svc": {Username: "svc", Password: "p4"}},
+		APIKey:   "key",
+		Internal: "hidden",
+		Secret:   "s",
+	}
+
+	got, err := ToJSONRedacted(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"created_at":"2026-01-02T03:04:05Z",` +
+		`"creds":{"username":"john","password":"***"},` +
+		`"tokens":[{"username":"a","password":"***"},{"username":"b","password":"***"}],` +
+		`"by_name":{"svc":{"username":"svc","password":"***"}},` +
+		`"api_key":"***","Secret":"***"}`
+	if got != want {
+		t.Errorf("ToJSONRedacted() =\n%s\nwant\n%s", got, want)
+	}
+
+	// Pointers and slices of pointers are redacted as well
+	req.Backup = &redactCredentials{Username: "backup", Password: "p5"}
+	got, err = ToJSONRedacted([]*redactRequest{&req, nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"p1", "p2", "p3", "p4", "p5", `"key"`, "hidden"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("output leaks %s: %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"backup":{"username":"backup","password":"***"}`) || !strings.Contains(got, `,null]`) {
+		t.Errorf("unexpected output %s", got)
+	}
+}
+
+func TestToJSONRedactedKeepsEmptyRedactedFields(t *testing.T) {
+	got, err := ToJSONRedacted(redactCredentials{Username: "john"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"username":"john","password":"***"}`; got != want {
+		t.Errorf("ToJSONRedacted() = %s, want %s", got, want)
+	}
+}
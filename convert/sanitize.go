@@ -0,0 +1,102 @@
+// Package convert provides utilities for sanitizing maps before they are
+// written to logs, removing large, binary and sensitive values.
+package convert
+
+import (
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultSanitizeKeys are keys masked by SanitizeForLog when no SensitiveKeys are configured.
+var DefaultSanitizeKeys = []string{"password", "token", "secret", "api_key", "access_token", "refresh_token", "authorization"}
+
+// SanitizeOptions configures SanitizeForLog.
+type SanitizeOptions struct {
+	MaxStringLength int      // Strings longer than this are truncated (default 256 runes)
+	SensitiveKeys   []string // Keys to mask, case-insensitive substring match (default DefaultSanitizeKeys)
+	Mask            string   // Replacement for sensitive values (default "***")
+}
+
+// SanitizeForLog returns a copy of m that is safe to log. The input is never modified.
+//
+// Nested maps and slices are sanitized recursively:
+//   - Values of keys containing a sensitive key (e.g. "user_password") are masked
+//   - Strings longer than MaxStringLength are truncated with a "...(N chars)" suffix
+//   - []byte values and uploaded files become a placeholder with their size
+//
+// Example:
+//
+//	params := c.MustGet("params").(map[string]interface{})
+//	logger.WithField("params", convert.SanitizeForLog(params, convert.SanitizeOptions{})).Info("Request")
+//	// {"password":"***","avatar":"[binary 52341 bytes]","bio":"Lorem ipsum...(1200 chars)"}
+func SanitizeForLog(m map[string]interface{}, opts SanitizeOptions) map[string]interface{} {
+	if opts.MaxStringLength <= 0 {
+		opts.MaxStringLength = 256
+	}
+	if opts.SensitiveKeys == nil {
+		opts.SensitiveKeys = DefaultSanitizeKeys
+	}
+	if opts.Mask == "" {
+		opts.Mask = "***"
+	}
+	if m == nil {
+		return nil
+	}
+	return sanitizeMap(m, opts)
+}
+
+// sanitizeMap sanitizes each entry of a map
+func sanitizeMap(m map[string]interface{}, opts SanitizeOptions) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		if isSensitiveKey(key, opts.SensitiveKeys) {
+			out[key] = opts.Mask
+			continue
+		}
+		out[key] = sanitizeValue(value, opts)
+	}
+	return out
+}
+
+// sanitizeValue sanitizes a single value
+func sanitizeValue(value interface{}, opts SanitizeOptions) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return sanitizeMap(v, opts)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = sanitizeValue(item, opts)
+		}
+		return out
+	case string:
+		if n := utf8.RuneCountInString(v); n > opts.MaxStringLength {
+			return fmt.Sprintf("%s...(%d chars)", string([]rune(v)[:opts.MaxStringLength]), n)
+		}
+		return v
+	case []byte:
+		return fmt.Sprintf("[binary %d bytes]", len(v))
+	case *multipart.FileHeader:
+		return fmt.Sprintf("[file %s %d bytes]", v.Filename, v.Size)
+	case []*multipart.FileHeader:
+		out := make([]interface{}, len(v))
+		for i, file := range v {
+			out[i] = sanitizeValue(file, opts)
+		}
+		return out
+	}
+	return value
+}
+
+// isSensitiveKey checks if key contains any sensitive key, ignoring case
+func isSensitiveKey(key string, keys []string) bool {
+	lower := strings.ToLower(key)
+	for _, k := range keys {
+		if k != "" && strings.Contains(lower, strings.ToLower(k)) {
+			return true
+		}
+	}
+	return false
+}
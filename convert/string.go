@@ -0,0 +1,96 @@
+// Package convert provides string helpers: rune-aware truncation of UTF-8 text
+// and splitting of delimited lists such as query parameters.
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TruncateString shortens s to at most maxRunes runes (characters, not bytes), so
+// multi-byte characters such as Thai or emoji are never split.
+//
+// When s is longer than maxRunes it is cut and ellipsis is appended; the ellipsis counts
+// toward maxRunes, so the result always fits. Strings that fit are returned unchanged.
+// A maxRunes of 0 or less returns "".
+//
+// Example:
+//
+//	convert.TruncateString("Hello, World", 8, "...")   // Returns "Hello..."
+//	convert.TruncateString("สวัสดีครับ", 5, "…")         // Returns "สวัส…"
+//	convert.TruncateString("short", 10, "...")          // Returns "short"
+func TruncateString(s string, maxRunes int, ellipsis string) string {
+	if maxRunes <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	ellipsisRunes := utf8.RuneCountInString(ellipsis)
+	if ellipsisRunes >= maxRunes {
+		return prefixRunes(ellipsis, maxRunes)
+	}
+	return prefixRunes(s, maxRunes-ellipsisRunes) + ellipsis
+}
+
+// prefixRunes returns the first n runes of s
+func prefixRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// StringToSlice splits s on sep and drops empty elements, so trailing or repeated
+// separators do not produce blanks. With trim, each element is trimmed of surrounding
+// whitespace first (and whitespace-only elements are dropped). An empty sep means ",".
+// Always returns a non-nil slice.
+//
+// Example:
+//
+//	convert.StringToSlice("a, b,,c,", ",", true)   // Returns ["a", "b", "c"]
+//	convert.StringToSlice("a, b", ",", false)      // Returns ["a", " b"]
+//	convert.StringToSlice("x|y", "|", false)       // Returns ["x", "y"]
+//	convert.StringToSlice("", ",", true)           // Returns []
+func StringToSlice(s string, sep string, trim bool) []string {
+	if sep == "" {
+		sep = ","
+	}
+
+	result := []string{}
+	for _, item := range strings.Split(s, sep) {
+		if trim {
+			item = strings.TrimSpace(item)
+		}
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// StringToIntSlice splits s on sep like StringToSlice (with trimming) and parses
+// each element as an int. Returns an error naming the first element that is not an integer.
+//
+// Example:
+//
+//	ids, err := convert.StringToIntSlice(c.Query("ids"), ",") // "1, 2,3," returns [1, 2, 3]
+//	ids, err = convert.StringToIntSlice("1,x", ",")           // Returns error
+func StringToIntSlice(s string, sep string) ([]int, error) {
+	items := StringToSlice(s, sep, true)
+	result := make([]int, len(items))
+	for i, item := range items {
+		num, err := strconv.Atoi(numberString(item))
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q at position %d", item, i)
+		}
+		result[i] = num
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		maxRunes int
+		ellipsis string
+		want     string
+	}{
+		{"ascii", "Hello, World", 8, "...", "Hello..."},
+		{"fits", "short", 10, "...", "short"},
+		{"exact length", "exact", 5, "...", "exact"},
+		{"thai", "สวัสดีครับ", 5, "…", "สวัส…"},
+		{"thai without ellipsis", "ภาษาไทย", 4, "", "ภาษา"},
+		{"thai fits by runes not bytes", "สวัสดี", 6, "...", "สวัสดี"},
+		{"emoji", "👍🎉🚀✨🔥", 3, "…", "👍🎉…"},
+		{"emoji with ascii ellipsis", "ok👍🎉🚀", 4, "..", "ok.."},
+		{"ellipsis longer than max", "Hello, World", 2, "...", ".."},
+		{"zero", "Hello", 0, "...", ""},
+		{"negative", "Hello", -1, "...", ""},
+		{"empty", "", 3, "...", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateString(tt.s, tt.maxRunes, tt.ellipsis)
+			if got != tt.want {
+				t.Errorf("TruncateString(%q, %d, %q) = %q, want %q", tt.s, tt.maxRunes, tt.ellipsis, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("result %q is not valid UTF-8", got)
+			}
+			if tt.maxRunes > 0 && utf8.RuneCountInString(got) > tt.maxRunes {
+				t.Errorf("result %q has more than %d runes", got, tt.maxRunes)
+			}
+		})
+	}
+}
+
+func TestStringToSlice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		sep  string
+		trim bool
+		want []string
+	}{
+		{"trailing separator", "1,2,3,", ",", false, []string{"1", "2", "3"}},
+		{"repeated separators", "a,,b,,,c", ",", false, []string{"a", "b", "c"}},
+		{"leading separator", ",a,b", ",", true, []string{"a", "b"}},
+		{"whitespace trimmed", " a , b ,\tc\n", ",", true, []string{"a", "b", "c"}},
+		{"whitespace kept", "a, b", ",", false, []string{"a", " b"}},
+		{"whitespace-only elements dropped", "a, ,b,  ", ",", true, []string{"a", "b"}},
+		{"whitespace-only elements kept", "a, ,b", ",", false, []string{"a", " ", "b"}},
+		{"custom separator", "x|y||z|", "|", false, []string{"x", "y", "z"}},
+		{"multi-char separator", "a::b::", "::", false, []string{"a", "b"}},
+		{"default separator", "a,b", "", false, []string{"a", "b"}},
+		{"empty", "", ",", true, []string{}},
+		{"only separators", ",,,", ",", true, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StringToSlice(tt.s, tt.sep, tt.trim)
+			if got == nil {
+				t.Fatal("result must not be nil")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StringToSlice(%q, %q, %v) = %q, want %q", tt.s, tt.sep, tt.trim, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringToIntSlice(t *testing.T) {
+	got, err := StringToIntSlice(" 1, 2,3, ,", ",")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("StringToIntSlice() = %v, want [1 2 3]", got)
+	}
+
+	if got, err := StringToIntSlice("", ","); err != nil || len(got) != 0 {
+		t.Errorf("StringToIntSlice(\"\") = %v, %v; want empty", got, err)
+	}
+
+	_, err = StringToIntSlice("1,x,3", ",")
+	if err == nil || !strings.Contains(err.Error(), `"x"`) || !strings.Contains(err.Error(), "position 1") {
+		t.Errorf("error = %v, want it to name \"x\" at position 1", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Package convert provides utilities for parsing and converting time values
+// from strings and epoch numbers in various formats.
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unixMillisThreshold separates epoch seconds from milliseconds in ToTime.
+// 1e12 milliseconds is September 2001, while 1e12 seconds is beyond year 30000.
+const unixMillisThreshold = 1e12
+
+// DefaultTimeLayouts are the layouts tried by ToTime after any caller-provided layouts.
+// Layouts without a zone are parsed as UTC.
+var DefaultTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05-07", // helper.TimestampLayout
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"02/01/2006",
+}
+
+// ToTime converts a value to time.Time.
+// Strings are parsed with the provided layouts first, then DefaultTimeLayouts.
+// Numbers (and numeric strings that match no layout) are treated as Unix epoch values:
+// magnitudes of 1e12 or more are milliseconds, smaller ones are seconds.
+// time.Time values are returned as is. Nil and empty strings return the zero time.
+//
+// Example:
+//
+//	t, err := convert.ToTime("2024-01-15")                // 2024-01-15 00:00:00 UTC
+//	t, err = convert.ToTime("15/01/2024")                 // 2024-01-15 00:00:00 UTC
+//	t, err = convert.ToTime("15 Jan 2024", "02 Jan 2006") // Custom layout
+//	t, err = convert.ToTime(1705276800)                   // 2024-01-15 00:00:00 UTC
+//	t, err = convert.ToTime(1705276800123)                // 2024-01-15 00:00:00.123 UTC
+func ToTime(value interface{}, layouts ...string) (time.Time, error) {
+	if value == nil {
+		return time.Time{}, nil
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, nil
+		}
+		return *v, nil
+	case string:
+		return parseTimeString(v, layouts)
+	case json.Number:
+		return parseTimeString(v.String(), layouts)
+	}
+
+	n, err := ToInt64(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot convert %T to time.Time", value)
+	}
+	return fromEpoch(n), nil
+}
+
+// fromEpoch converts epoch seconds or milliseconds, detected by magnitude
+func fromEpoch(n int64) time.Time {
+	if n >= unixMillisThreshold || n <= -unixMillisThreshold {
+		return FromUnixMillis(n)
+	}
+	return FromUnix(n)
+}
+
+// parseTimeString tries each layout in order, then falls back to epoch values
+func parseTimeString(s string, layouts []string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	tried := make([]string, 0, len(layouts)+len(DefaultTimeLayouts))
+	tried = append(tried, layouts...)
+	tried = append(tried, DefaultTimeLayouts...)
+	for _, layout := range tried {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return fromEpoch(n), nil
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse %q as time, tried layouts: %s", s, strings.Join(tried, ", "))
+}
@@ -4,13 +4,16 @@
 package convert
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ToString converts any value to its string representation.
 // Returns an empty string for nil values.
-// Handles string, int, int64, float32, float64, bool, and other types via fmt.Sprintf.
+// Handles string, int, int64, float32, float64, bool, json.Number, and other types via fmt.Sprintf.
 //
 // Example:
 //
@@ -37,13 +40,15 @@ func ToString(value interface{}) string {
 		return strconv.FormatFloat(float64(v), 'f', -1, 32)
 	case bool:
 		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
 	default:
 		return fmt.Sprintf("%v", v)
 	}
 }
 
 // ToInt converts various types to an integer value.
-// Supports conversion from int, int64, float32, float64, string, bool, and nil.
+// Supports conversion from int, int64, float32, float64, json.Number, string, bool, and nil.
 // Returns an error if the conversion is not possible.
 //
 // Example:
@@ -53,6 +58,7 @@ func ToString(value interface{}) string {
 //	num, err = convert.ToInt(true)         // Returns 1
 //	num, err = convert.ToInt(false)        // Returns 0
 //	num, err = convert.ToInt(nil)          // Returns 0
+//	num, err = convert.ToInt("๑๒๓")        // Returns 123 (see NormalizeNumberStrings)
 //	num, err = convert.ToInt("abc")        // Returns error
 func ToInt(value interface{}) (int, error) {
 	if value == nil {
@@ -68,8 +74,11 @@ func ToInt(value interface{}) (int, error) {
 		return int(v), nil
 	case float32:
 		return int(v), nil
+	case json.Number:
+		n, err := jsonNumberToInt64(v)
+		return int(n), err
 	case string:
-		return strconv.Atoi(v)
+		return strconv.Atoi(numberString(v))
 	case bool:
 		if v {
 			return 1, nil
@@ -81,7 +90,8 @@ func ToInt(value interface{}) (int, error) {
 }
 
 // ToInt64 converts various types to a 64-bit integer value.
-// Supports conversion from int64, int, float32, float64, string, bool, and nil.
+// Supports conversion from int64, int, float32, float64, json.Number, string, bool, and nil.
+// json.Number values keep full int64 precision (see FromJSONNumber).
 // Returns an error if the conversion is not possible.
 //
 // Example:
@@ -105,8 +115,10 @@ func ToInt64(value interface{}) (int64, error) {
 		return int64(v), nil
 	case float32:
 		return int64(v), nil
+	case json.Number:
+		return jsonNumberToInt64(v)
 	case string:
-		return strconv.ParseInt(v, 10, 64)
+		return strconv.ParseInt(numberString(v), 10, 64)
 	case bool:
 		if v {
 			return 1, nil
@@ -118,7 +130,7 @@ func ToInt64(value interface{}) (int64, error) {
 }
 
 // ToFloat64 converts various types to a 64-bit floating-point value.
-// Supports conversion from float64, float32, int, int64, string, bool, and nil.
+// Supports conversion from float64, float32, int, int64, json.Number, string, bool, and nil.
 // Returns an error if the conversion is not possible.
 //
 // Example:
@@ -143,8 +155,10 @@ func ToFloat64(value interface{}) (float64, error) {
 		return float64(v), nil
 	case int64:
 		return float64(v), nil
+	case json.Number:
+		return v.Float64()
 	case string:
-		return strconv.ParseFloat(v, 64)
+		return strconv.ParseFloat(numberString(v), 64)
 	case bool:
 		if v {
 			return 1.0, nil
@@ -158,6 +172,7 @@ func ToFloat64(value interface{}) (float64, error) {
 // ToBool converts various types to a boolean value.
 // Returns false for nil, zero values, empty strings, and "false" strings.
 // Returns true for non-zero numbers and "true"/"1"/"t"/"T"/"TRUE" strings.
+// Unrecognized strings silently become false; use ToBoolStrict to validate input.
 //
 // Example:
 //
@@ -188,6 +203,46 @@ func ToBool(value interface{}) bool {
 	}
 }
 
+// ToBoolStrict converts a value to a boolean, returning an error for unrecognized input.
+// Prefer it over ToBool when validating request input, where "maybe" should be rejected
+// instead of silently becoming false.
+//
+// Accepts bool, the integers 0 and 1, and (case-insensitive, trimmed) the strings
+// "true"/"false", "1"/"0", "t"/"f", "yes"/"no", "y"/"n" and "on"/"off".
+//
+// Example:
+//
+//	b, err := convert.ToBoolStrict("yes")   // Returns true, nil
+//	b, err = convert.ToBoolStrict("off")    // Returns false, nil
+//	b, err = convert.ToBoolStrict(1)        // Returns true, nil
+//	b, err = convert.ToBoolStrict("maybe")  // Returns error
+//	b, err = convert.ToBoolStrict(2)        // Returns error
+//	b, err = convert.ToBoolStrict(nil)      // Returns error
+func ToBoolStrict(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "1", "t", "yes", "y", "on":
+			return true, nil
+		case "false", "0", "f", "no", "n", "off":
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot convert %q to bool", v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		switch ToString(v) {
+		case "1":
+			return true, nil
+		case "0":
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot convert %v to bool, expected 0 or 1", v)
+	default:
+		return false, fmt.Errorf("cannot convert %T to bool", value)
+	}
+}
+
 // ToStringSlice converts any value to a string slice.
 // If the input is already a []string, returns it as-is.
 // If the input is []interface{}, converts each element to string.
@@ -257,3 +312,42 @@ func ToIntSlice(value interface{}) ([]int, error) {
 		return []int{num}, nil
 	}
 }
+
+// ToUnix converts a time to Unix epoch seconds.
+//
+// Example:
+//
+//	sec := convert.ToUnix(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) // Returns 1705276800
+func ToUnix(t time.Time) int64 {
+	return t.Unix()
+}
+
+// FromUnix converts Unix epoch seconds to a UTC time.
+//
+// Example:
+//
+//	t := convert.FromUnix(1705276800) // Returns 2024-01-15 00:00:00 UTC
+func FromUnix(sec int64) time.Time {
+	return time.Unix(sec, 0).UTC()
+}
+
+// FromUnixMillis converts Unix epoch milliseconds to a UTC time.
+//
+// Example:
+//
+//	t := convert.FromUnixMillis(1705276800123) // Returns 2024-01-15 00:00:00.123 UTC
+func FromUnixMillis(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
+
+// jsonNumberToInt64 parses a json.Number as int64, truncating decimals like float64 input
+func jsonNumberToInt64(n json.Number) (int64, error) {
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert json.Number %q to int64", n.String())
+	}
+	return int64(f), nil
+}
@@ -0,0 +1,57 @@
+package convert
+
+import "testing"
+
+func TestToBoolStrict(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		want    bool
+		wantErr bool
+	}{
+		{true, true, false},
+		{false, false, false},
+		{"true", true, false},
+		{"FALSE", false, false},
+		{" Yes ", true, false},
+		{"no", false, false},
+		{"y", true, false},
+		{"N", false, false},
+		{"on", true, false},
+		{"Off", false, false},
+		{"t", true, false},
+		{"f", false, false},
+		{"1", true, false},
+		{"0", false, false},
+		{1, true, false},
+		{0, false, false},
+		{int64(1), true, false},
+		{uint8(0), false, false},
+		{float64(1), true, false},
+
+		// Ambiguous input that ToBool would silently turn into false
+		{"maybe", false, true},
+		{"", false, true},
+		{" ", false, true},
+		{"2", false, true},
+		{"-1", false, true},
+		{"yess", false, true},
+		{"truee", false, true},
+		{2, false, true},
+		{-1, false, true},
+		{0.5, false, true},
+		{nil, false, true},
+		{[]string{"true"}, false, true},
+		{map[string]bool{"ok": true}, false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ToBoolStrict(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ToBoolStrict(%#v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ToBoolStrict(%#v) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
@@ -38,3 +38,55 @@ func Contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+// IndexFunc returns the index of the first element matching pred, or -1
+//
+// Example:
+//
+//	i := helper.IndexFunc(users, func(u User) bool { return u.ID == id })
+func IndexFunc[T any](slice []T, pred func(T) bool) int {
+	for i, v := range slice {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsFunc checks if any element matches pred
+func ContainsFunc[T any](slice []T, pred func(T) bool) bool {
+	return IndexFunc(slice, pred) >= 0
+}
+
+// DiffSlices returns the elements to add to and remove from current to match desired
+// Elements are compared as sets: duplicates are reported once, in order of appearance,
+// and both results are nil when nothing changes
+//
+// Example:
+//
+//	toAdd, toRemove := helper.DiffSlices([]string{"a", "b"}, []string{"b", "c"})
+//	// toAdd = ["c"], toRemove = ["a"]
+func DiffSlices[T comparable](current, desired []T) (toAdd, toRemove []T) {
+	inCurrent := make(map[T]bool, len(current))
+	for _, v := range current {
+		inCurrent[v] = true
+	}
+	inDesired := make(map[T]bool, len(desired))
+	for _, v := range desired {
+		inDesired[v] = true
+	}
+
+	for _, v := range desired {
+		if !inCurrent[v] {
+			toAdd = append(toAdd, v)
+			inCurrent[v] = true
+		}
+	}
+	for _, v := range current {
+		if !inDesired[v] {
+			toRemove = append(toRemove, v)
+			inDesired[v] = true
+		}
+	}
+	return toAdd, toRemove
+}
@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+type arrayTestUser struct {
+	ID   int
+	Name string
+}
+
+func TestIndexFuncAndContainsFunc(t *testing.T) {
+	users := []arrayTestUser{
+		{ID: 1, Name: "somchai"},
+		{ID: 2, Name: "suda"},
+		{ID: 3, Name: "suda"},
+	}
+	byID := func(id int) func(arrayTestUser) bool {
+		return func(u arrayTestUser) bool { return u.ID == id }
+	}
+
+	tests := []struct {
+		name      string
+		slice     []arrayTestUser
+		pred      func(arrayTestUser) bool
+		wantIndex int
+	}{
+		{"match by ID", users, byID(2), 1},
+		{"first of several matches", users, func(u arrayTestUser) bool { return u.Name == "suda" }, 1},
+		{"first element", users, byID(1), 0},
+		{"no match", users, byID(99), -1},
+		{"nil slice", nil, byID(1), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexFunc(tt.slice, tt.pred); got != tt.wantIndex {
+				t.Errorf("IndexFunc() = %d, want %d", got, tt.wantIndex)
+			}
+			if got, want := ContainsFunc(tt.slice, tt.pred), tt.wantIndex >= 0; got != want {
+				t.Errorf("ContainsFunc() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffSlices(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    []string
+		desired    []string
+		wantAdd    []string
+		wantRemove []string
+	}{
+		{"overlap", []string{"admin", "editor"}, []string{"editor", "viewer"}, []string{"viewer"}, []string{"admin"}},
+		{"full replacement", []string{"a", "b"}, []string{"c", "d"}, []string{"c", "d"}, []string{"a", "b"}},
+		{"no change", []string{"a", "b"}, []string{"b", "a"}, nil, nil},
+		{"duplicates reported once", []string{"a", "a", "b"}, []string{"c", "c"}, []string{"c"}, []string{"a", "b"}},
+		{"empty current", []string{}, []string{"a"}, []string{"a"}, nil},
+		{"nil desired", []string{"a"}, nil, nil, []string{"a"}},
+		{"both nil", nil, nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := DiffSlices(tt.current, tt.desired)
+			if !reflect.DeepEqual(toAdd, tt.wantAdd) {
+				t.Errorf("toAdd = %#v, want %#v", toAdd, tt.wantAdd)
+			}
+			if !reflect.DeepEqual(toRemove, tt.wantRemove) {
+				t.Errorf("toRemove = %#v, want %#v", toRemove, tt.wantRemove)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContextKeyRawBody is the Gin context key for the body cached by ReadAndRestoreBody
+const ContextKeyRawBody = "raw_body"
+
+// ErrBodyTooLarge is returned by ReadAndRestoreBody when the body exceeds maxBytes
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// ReadAndRestoreBody reads the request body and replaces c.Request.Body with a fresh
+// reader over the same bytes, so later middlewares and handlers can read it again.
+// The bytes are cached in context; repeated calls return the cache and reset the body.
+//
+// maxBytes limits the body size (0 or less means no limit). A larger body returns
+// ErrBodyTooLarge, is not cached, and is left readable in full.
+// Requests without a body return nil.
+//
+// Example:
+//
+//	body, err := helper.ReadAndRestoreBody(c, 1<<20)
+//	if errors.Is(err, helper.ErrBodyTooLarge) {
+//	    helper.ErrorResponse(c, 413, "BODY_TOO_LARGE", "Request body exceeds 1MB")
+//	    return
+//	}
+func ReadAndRestoreBody(c *gin.Context, maxBytes int64) ([]byte, error) {
+	if cached, ok := GetValue[[]byte](c, ContextKeyRawBody); ok {
+		if maxBytes > 0 && int64(len(cached)) > maxBytes {
+			return nil, ErrBodyTooLarge
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(cached))
+		return cached, nil
+	}
+
+	if c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	reader := io.Reader(c.Request.Body)
+	if maxBytes > 0 {
+		reader = io.LimitReader(c.Request.Body, maxBytes+1)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBytes > 0 && int64(len(body)) > maxBytes {
+		c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+		return nil, ErrBodyTooLarge
+	}
+
+	c.Set(ContextKeyRawBody, body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,181 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// durationType is handled separately so min and max accept values like "1s"
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ValidateConfig checks an application config struct against its `validate` tags and
+// returns every problem at once as a *MultiError of *ValidationError (Field is the
+// dotted field path), or nil. Call it at startup, before serving requests.
+//
+// Rules, separated by commas:
+//   - required: the value must not be the zero value
+//   - url: a URL with scheme and host
+//   - min=N, max=N: bounds for numbers (durations accept "30s"), rune length
+//     for strings and length for slices and maps
+//   - oneof=a b c: the value must be one of the space-separated options
+//
+// url and oneof skip zero values; combine them with required. Nested structs and
+// non-nil struct pointers are validated as well.
+//
+// Example:
+//
+//	type AppConfig struct {
+//	    Env       string        `validate:"required,oneof=dev staging prod"`
+//	    JWTSecret string        `validate:"required,min=32"`
+//	    Timeout   time.Duration `validate:"min=1s,max=1m"`
+//	    MinIO     struct {
+//	        Endpoint string `validate:"required"`
+//	        URI      string `validate:"url"`
+//	    }
+//	}
+//
+//	if err := helper.ValidateConfig(cfg); err != nil {
+//	    log.Fatal(err) // lists every invalid field
+//	}
+func ValidateConfig(cfg interface{}) error {
+	v := reflect.ValueOf(cfg)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return errors.New("config is nil")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("config must be a struct, got %s", v.Kind())
+	}
+
+	var errs MultiError
+	validateConfigStruct(v, "", &errs)
+	return errs.ErrorOrNil()
+}
+
+// validateConfigStruct validates the exported fields of v and its nested structs
+func validateConfigStruct(v reflect.Value, prefix string, errs *MultiError) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := prefix + field.Name
+		value := v.Field(i)
+
+		if tag := field.Tag.Get("validate"); tag != "" && tag != "-" {
+			for _, rule := range strings.Split(tag, ",") {
+				errs.Add(validateConfigRule(value, name, strings.TrimSpace(rule)))
+			}
+		}
+
+		if value.Kind() == reflect.Pointer && !value.IsNil() {
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Struct && value.Type() != reflect.TypeOf(time.Time{}) {
+			validateConfigStruct(value, name+".", errs)
+		}
+	}
+}
+
+// validateConfigRule checks a single rule such as "required" or "min=3"
+func validateConfigRule(value reflect.Value, name string, rule string) error {
+	ruleName, arg, _ := strings.Cut(rule, "=")
+	if ruleName == "required" {
+		if value.IsZero() {
+			return NewValidationError(ValidationCodeEmpty, name, fmt.Sprintf("%s is required", name))
+		}
+		return nil
+	}
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch ruleName {
+	case "":
+		return nil
+	case "url":
+		if value.IsZero() {
+			return nil
+		}
+		if value.Kind() != reflect.String {
+			return NewValidationError(ValidationCodeNotString, name, fmt.Sprintf("%s must be a string URL", name))
+		}
+		u, err := url.Parse(value.String())
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return NewValidationError(ValidationCodeInvalidFormat, name, fmt.Sprintf("%s must be a valid URL", name))
+		}
+	case "oneof":
+		if value.IsZero() {
+			return nil
+		}
+		options := strings.Fields(arg)
+		actual := fmt.Sprint(value.Interface())
+		for _, option := range options {
+			if actual == option {
+				return nil
+			}
+		}
+		return NewValidationError(ValidationCodeNotAllowed, name, fmt.Sprintf("%s must be one of: %s", name, strings.Join(options, ", ")))
+	case "min", "max":
+		return validateConfigBound(value, name, ruleName, arg)
+	default:
+		return fmt.Errorf("%s: unknown validate rule %q", name, rule)
+	}
+	return nil
+}
+
+// validateConfigBound checks a min or max rule against a number or a length
+func validateConfigBound(value reflect.Value, name string, ruleName string, arg string) error {
+	var actual, bound float64
+	var err error
+	subject, code := name, ValidationCodeOutOfRange
+
+	switch {
+	case value.Type() == durationType:
+		var d time.Duration
+		d, err = time.ParseDuration(arg)
+		actual, bound = float64(value.Int()), float64(d)
+	case value.CanInt():
+		actual = float64(value.Int())
+		bound, err = strconv.ParseFloat(arg, 64)
+	case value.CanUint():
+		actual = float64(value.Uint())
+		bound, err = strconv.ParseFloat(arg, 64)
+	case value.CanFloat():
+		actual = value.Float()
+		bound, err = strconv.ParseFloat(arg, 64)
+	case value.Kind() == reflect.String:
+		actual = float64(utf8.RuneCountInString(value.String()))
+		bound, err = strconv.ParseFloat(arg, 64)
+		subject, code = "length of "+name, ValidationCodeInvalidLength
+	case value.Kind() == reflect.Slice || value.Kind() == reflect.Map || value.Kind() == reflect.Array:
+		actual = float64(value.Len())
+		bound, err = strconv.ParseFloat(arg, 64)
+		subject, code = "length of "+name, ValidationCodeInvalidLength
+	default:
+		return fmt.Errorf("%s: %s is not supported for %s", name, ruleName, value.Kind())
+	}
+	if err != nil {
+		return fmt.Errorf("%s: invalid %s value %q", name, ruleName, arg)
+	}
+
+	if ruleName == "min" && actual < bound {
+		return NewValidationError(code, name, fmt.Sprintf("%s must be at least %s", subject, arg))
+	}
+	if ruleName == "max" && actual > bound {
+		return NewValidationError(code, name, fmt.Sprintf("%s must be at most %s", subject, arg))
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type testMinIOConfig struct {
+	Endpoint string `validate:"required"`
+	URI      string `validate:"url"`
+}
+
+type testAppConfig struct {
+	Env       string        `validate:"required,oneof=dev staging prod"`
+	JWTSecret string        `validate:"required,min=32"`
+	Timeout   time.Duration `validate:"min=1s,max=1m"`
+	Workers   int           `validate:"min=1,max=64"`
+	MinIO     testMinIOConfig
+	Cache     *testMinIOConfig
+}
+
+func validTestAppConfig() testAppConfig {
+	return testAppConfig{
+		Env:       "prod",
+		JWTSecret: "0123456789abcdef0123456789abcdef",
+		Timeout:   30 * time.Second,
+		Workers:   8,
+		MinIO:     testMinIOConfig{Endpoint: "minio:9000", URI: "https://minio.example.com"},
+	}
+}
+
+func TestValidateConfigValid(t *testing.T) {
+	cfg := validTestAppConfig()
+	if err := ValidateConfig(&cfg); err != nil {
+		t.Fatalf("ValidateConfig() = %v, want nil", err)
+	}
+	if err := ValidateConfig(cfg); err != nil {
+		t.Fatalf("ValidateConfig(value) = %v, want nil", err)
+	}
+}
+
+func TestValidateConfigReportsEveryError(t *testing.T) {
+	cfg := testAppConfig{
+		Env:       "qa",
+		JWTSecret: "short",
+		Timeout:   500 * time.Millisecond,
+		Workers:   100,
+		MinIO:     testMinIOConfig{URI: "not a url"},
+		Cache:     &testMinIOConfig{},
+	}
+
+	err := ValidateConfig(&cfg)
+	var multi *MultiError
+	if !errors.As(err, &multi) {
+		t.Fatalf("ValidateConfig() = %T %v, want *MultiError", err, err)
+	}
+
+	want := map[string]string{
+		"Env":            ValidationCodeNotAllowed,
+		"JWTSecret":      ValidationCodeInvalidLength,
+		"Timeout":        ValidationCodeOutOfRange,
+		"Workers":        ValidationCodeOutOfRange,
+		"MinIO.Endpoint": ValidationCodeEmpty,
+		"MinIO.URI":      ValidationCodeInvalidFormat,
+		"Cache.Endpoint": ValidationCodeEmpty,
+	}
+	errs := multi.Errors()
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), err)
+	}
+	for _, e := range errs {
+		var ve *ValidationError
+		if !errors.As(e, &ve) {
+			t.Errorf("error %v is %T, want *ValidationError", e, e)
+			continue
+		}
+		code, ok := want[ve.Field]
+		if !ok {
+			t.Errorf("unexpected error for field %q: %v", ve.Field, ve)
+			continue
+		}
+		if ve.Code != code {
+			t.Errorf("field %q code = %q, want %q", ve.Field, ve.Code, code)
+		}
+		delete(want, ve.Field)
+	}
+	for field := range want {
+		t.Errorf("no error reported for field %q", field)
+	}
+}
+
+func TestValidateConfigInvalidInput(t *testing.T) {
+	var nilCfg *testAppConfig
+	if err := ValidateConfig(nilCfg); err == nil {
+		t.Error("ValidateConfig(nil pointer) = nil, want error")
+	}
+	if err := ValidateConfig("config"); err == nil {
+		t.Error("ValidateConfig(string) = nil, want error")
+	}
+
+	var bad struct {
+		Port int `validate:"between=1 10"`
+	}
+	err := ValidateConfig(&bad)
+	if err == nil {
+		t.Fatal("ValidateConfig(unknown rule) = nil, want error")
+	}
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		t.Errorf("unknown rule reported as validation error %v", ve)
+	}
+}
@@ -1,6 +1,10 @@
 package helper
 
 import (
+	"context"
+	"net"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -8,9 +12,17 @@ import (
 // Context keys
 const (
 	ContextKeyUserID     = "user_id"
+	ContextKeyUserRole   = "user_role"
+	ContextKeyUserEmail  = "user_email"
+	ContextKeyRequestID  = "request_id"
 	ContextKeyAPIKeyAuth = "apiKey"
+	ContextKeyParams     = "params"
+	ContextKeyToken      = "access_token"
 )
 
+// detachedKey is the key type for values copied by DetachContext
+type detachedKey string
+
 // GetUserIDFromContext retrieves user ID from context
 func GetUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
 	userID, exists := c.Get(ContextKeyUserID)
@@ -22,11 +34,105 @@ func GetUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
 	return id, ok
 }
 
+// GetUserIDStringFromContext retrieves user ID from context as a string
+// Works for both UUID user IDs and non-UUID string IDs (e.g. from a "sub" claim).
+func GetUserIDStringFromContext(c *gin.Context) (string, bool) {
+	userID, exists := c.Get(ContextKeyUserID)
+	if !exists {
+		return "", false
+	}
+
+	switch id := userID.(type) {
+	case uuid.UUID:
+		return id.String(), true
+	case string:
+		return id, id != ""
+	}
+	return "", false
+}
+
 // GetIPAddress retrieves client IP address
 func GetIPAddress(c *gin.Context) string {
 	return c.ClientIP()
 }
 
+// RealClientIP resolves the client IP from X-Forwarded-For, trusting only the given proxies.
+//
+// trustedProxies holds CIDRs or single IPs (e.g. "10.0.0.0/8", "192.168.1.10").
+// If the direct peer is not a trusted proxy, its address is returned and the header is ignored,
+// so clients cannot spoof their IP. Otherwise the header is walked right to left and the first
+// address that is not a trusted proxy is returned.
+//
+// With an empty list it falls back to c.ClientIP(), which honors the engine configuration:
+//
+//	r := gin.New()
+//	r.SetTrustedProxies([]string{"10.0.0.0/8"})
+func RealClientIP(c *gin.Context, trustedProxies []string) string {
+	if len(trustedProxies) == 0 {
+		return c.ClientIP()
+	}
+	trusted := parseTrustedProxies(trustedProxies)
+
+	remoteIP, _, err := net.SplitHostPort(strings.TrimSpace(c.Request.RemoteAddr))
+	if err != nil {
+		remoteIP = strings.TrimSpace(c.Request.RemoteAddr)
+	}
+	if !isTrustedProxy(remoteIP, trusted) {
+		return remoteIP
+	}
+
+	clientIP := remoteIP
+	hops := strings.Split(c.GetHeader("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		clientIP = hop
+		if !isTrustedProxy(hop, trusted) {
+			break
+		}
+	}
+	return clientIP
+}
+
+// parseTrustedProxies converts CIDRs and single IPs into networks
+func parseTrustedProxies(proxies []string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				continue
+			}
+			if ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			nets = append(nets, ipNet)
+		}
+	}
+	return nets
+}
+
+// isTrustedProxy checks if ip belongs to any of the trusted networks
+func isTrustedProxy(ip string, trusted []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range trusted {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetUserAgent retrieves user agent
 func GetUserAgent(c *gin.Context) string {
 	return c.Request.UserAgent()
@@ -41,3 +147,106 @@ func IsAPIKeyAuth(c *gin.Context) bool {
 	isAuth, ok := apiKeyAuth.(bool)
 	return ok && isAuth
 }
+
+// ExtractBearerToken returns the token of an "Authorization: Bearer <token>" header
+// The scheme is case-insensitive; false means the header is missing or malformed
+//
+// Example:
+//
+//	token, ok := helper.ExtractBearerToken(c)
+//	if ok {
+//	    req.Header.Set("Authorization", "Bearer "+token)
+//	}
+func ExtractBearerToken(c *gin.Context) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(c.GetHeader("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	if token == "" || strings.ContainsAny(token, " \t") {
+		return "", false
+	}
+	return token, true
+}
+
+// GetTokenFromContext retrieves the raw token stored by the JWT middlewares
+// Use it to relay the caller's token to downstream services
+func GetTokenFromContext(c *gin.Context) (string, bool) {
+	token, ok := GetValue[string](c, ContextKeyToken)
+	return token, ok && token != ""
+}
+
+// DetachContext creates a context for background work spawned from a handler.
+//
+// The returned context carries the request_id and user_id values (and any values of the
+// request context, e.g. tracing spans) but NOT its cancellation or deadline. Passing
+// c.Request.Context() to a goroutine instead would cancel the work as soon as the response
+// is written or the client disconnects; a detached context lets the work finish.
+// Read the values back with RequestIDFromContext and UserIDFromContext.
+//
+// Example:
+//
+//	ctx := helper.DetachContext(c)
+//	helper.Go(func() { sendWelcomeEmail(ctx, user) })
+func DetachContext(c *gin.Context) context.Context {
+	ctx := context.WithoutCancel(c.Request.Context())
+	if requestID, exists := c.Get(ContextKeyRequestID); exists {
+		ctx = context.WithValue(ctx, detachedKey(ContextKeyRequestID), requestID)
+	}
+	if userID, exists := c.Get(ContextKeyUserID); exists {
+		ctx = context.WithValue(ctx, detachedKey(ContextKeyUserID), userID)
+	}
+	return ctx
+}
+
+// RequestIDFromContext retrieves the request ID from a detached context or *gin.Context
+func RequestIDFromContext(ctx context.Context) string {
+	value := ctx.Value(detachedKey(ContextKeyRequestID))
+	if value == nil {
+		value = ctx.Value(ContextKeyRequestID)
+	}
+	requestID, _ := value.(string)
+	return requestID
+}
+
+// UserIDFromContext retrieves the user ID from a detached context or *gin.Context
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	value := ctx.Value(detachedKey(ContextKeyUserID))
+	if value == nil {
+		value = ctx.Value(ContextKeyUserID)
+	}
+	id, ok := value.(uuid.UUID)
+	return id, ok
+}
+
+// SetValue stores a request-scoped value in the gin context.
+//
+// Example:
+//
+//	helper.SetValue(c, "tenant_id", tenantID)
+func SetValue(c *gin.Context, key string, value interface{}) {
+	c.Set(key, value)
+}
+
+// GetValue retrieves a request-scoped value with type-safe conversion.
+// Returns false if the key is missing or holds a value of another type.
+//
+// Example:
+//
+//	tenantID, ok := helper.GetValue[uuid.UUID](c, "tenant_id")
+//	if !ok {
+//	    helper.ErrorResponse(c, 400, "MISSING_TENANT", "Tenant is required")
+//	    return
+//	}
+func GetValue[T any](c *gin.Context, key string) (T, bool) {
+	var zero T
+	value, exists := c.Get(key)
+	if !exists {
+		return zero, false
+	}
+	typed, ok := value.(T)
+	if !ok {
+		return zero, false
+	}
+	return typed, true
+}
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned by DecodeCursor for malformed or tampered tokens
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// CursorSecret signs the tokens of EncodeCursor. It defaults to a random secret, so tokens
+// are only valid within one process; set it once at startup to a shared secret when several
+// instances serve the same API.
+var CursorSecret = randomCursorSecret()
+
+// EncodeCursor encodes values (e.g. the sort key and ID of the last row) as an opaque,
+// HMAC-signed pagination token, so clients cannot forge cursors to read arbitrary data.
+// The token is URL-safe base64 JSON plus signature; it is signed, not encrypted.
+//
+// Example:
+//
+//	last := rows[len(rows)-1]
+//	next := helper.EncodeCursor(last.CreatedAt.Unix(), last.ID.String())
+//	helper.SuccessResponseWithMeta(c, 200, rows, map[string]interface{}{"next_cursor": next})
+func EncodeCursor(values ...interface{}) string {
+	payload, err := json.Marshal(values)
+	if err != nil {
+		payload = []byte("[]")
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + SignHMACSHA256(CursorSecret, []byte(encoded))
+}
+
+// DecodeCursor verifies a token from EncodeCursor and returns its values.
+// Numbers are returned as json.Number; read them with convert.ToInt64 or convert.ToFloat64.
+// Tampered or malformed tokens return an error wrapping ErrInvalidCursor.
+//
+// Example:
+//
+//	values, err := helper.DecodeCursor(c.Query("cursor"))
+//	if err != nil || len(values) != 2 {
+//	    helper.ErrorResponse(c, 400, "INVALID_CURSOR", "Invalid pagination cursor")
+//	    return
+//	}
+//	createdAt, _ := convert.ToInt64(values[0])
+//	lastID := convert.ToString(values[1])
+func DecodeCursor(token string) ([]interface{}, error) {
+	encoded, signature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || encoded == "" {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+	if !VerifyHMACSHA256(CursorSecret, []byte(encoded), signature) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidCursor)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var values []interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return values, nil
+}
+
+// randomCursorSecret returns a random per-process secret
+func randomCursorSecret() string {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return hex.EncodeToString(key)
+}
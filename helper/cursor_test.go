@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	token := EncodeCursor(int64(1767225600), "01ARZ3NDEKTSV4RRFFQ69G5FAV", true)
+	values, err := DecodeCursor(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []interface{}{json.Number("1767225600"), "01ARZ3NDEKTSV4RRFFQ69G5FAV", true}
+	if len(values) != len(want) {
+		t.Fatalf("values = %#v, want %#v", values, want)
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("values[%d] = %#v, want %#v", i, values[i], want[i])
+		}
+	}
+	if strings.ContainsAny(token, "+/=") {
+		t.Errorf("token %q is not URL-safe", token)
+	}
+}
+
+func TestDecodeCursorTampered(t *testing.T) {
+	token := EncodeCursor(int64(100), "user-1")
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`[0,"admin"]`))
+	var otherSecret string
+	func() {
+		saved := CursorSecret
+		CursorSecret = "another-instance"
+		defer func() { CursorSecret = saved }()
+		otherSecret = EncodeCursor(int64(100), "user-1")
+	}()
+	flipped := []byte(signature)
+	if flipped[0] == 'a' {
+		flipped[0] = 'b'
+	} else {
+		flipped[0] = 'a'
+	}
+
+	tests := map[string]string{
+		"forged payload":         forgedPayload + "." + signature,
+		"modified signature":     encoded + "." + string(flipped),
+		"truncated signature":    encoded + "." + signature[:len(signature)-2],
+		"missing signature":      encoded,
+		"empty signature":        encoded + ".",
+		"empty payload":          "." + signature,
+		"empty token":            "",
+		"other secret":           otherSecret,
+		"appended data":          token + "x",
+		"payload with extra dot": encoded + ".." + signature,
+		"plain base64 cursor":    base64.RawURLEncoding.EncodeToString([]byte(`[100,"user-1"]`)),
+	}
+
+	for name, tampered := range tests {
+		t.Run(name, func(t *testing.T) {
+			values, err := DecodeCursor(tampered)
+			if !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeCursor() = %v, %v; want ErrInvalidCursor", values, err)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorSignedButMalformed(t *testing.T) {
+	for _, payload := range []string{`{"id":1}`, `not json`} {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+		token := encoded + "." + SignHMACSHA256(CursorSecret, []byte(encoded))
+		if _, err := DecodeCursor(token); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("payload %s: err = %v, want ErrInvalidCursor", payload, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"runtime/debug"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Go runs fn in a new goroutine that recovers from panics.
+//
+// RecoveryMiddleware only protects the request goroutine: a panic in a goroutine spawned
+// by a handler crashes the whole process. Use Go instead of the go statement for any work
+// started from a handler. A recovered panic is logged with its stack trace through the
+// standard logrus logger.
+//
+// Example:
+//
+//	ctx := helper.DetachContext(c)
+//	helper.Go(func() {
+//	    sendWelcomeEmail(ctx, user)
+//	})
+func Go(fn func()) {
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"error": err,
+					"stack": string(debug.Stack()),
+				}).Error("Panic recovered in goroutine")
+			}
+		}()
+		fn()
+	}()
+}
@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// SignHMACSHA256 returns the hex-encoded HMAC-SHA256 of payload using secret
+func SignHMACSHA256(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyHMACSHA256 checks a hex-encoded HMAC-SHA256 signature in constant time.
+// An optional "sha256=" prefix on the signature is ignored.
+func VerifyHMACSHA256(secret string, payload []byte, signature string) bool {
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
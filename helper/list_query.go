@@ -0,0 +1,153 @@
+package helper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SortField is a single ORDER BY column parsed by ParseSort.
+type SortField struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc"`
+}
+
+// NewPaginatorFromQuery creates a Paginator from the "page" and "limit" query parameters.
+// Missing values default to page 1 and defaultLimit; limit must be between 1 and maxLimit.
+//
+// Example:
+//
+//	paginator, err := helper.NewPaginatorFromQuery(c, 20, 100)
+//	if err != nil {
+//	    helper.ValidationErrorResponse(c, err)
+//	    return
+//	}
+func NewPaginatorFromQuery(c *gin.Context, defaultLimit int, maxLimit int) (Paginator, error) {
+	paginator := NewPaginatorWithParams(1, defaultLimit)
+
+	if value := c.Query("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil {
+			return paginator, NewValidationError(ValidationCodeNotInt, "page", "page must be an integer")
+		}
+		if page < 1 {
+			return paginator, NewValidationError(ValidationCodeOutOfRange, "page", "page must be at least 1")
+		}
+		paginator.Page = page
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return paginator, NewValidationError(ValidationCodeNotInt, "limit", "limit must be an integer")
+		}
+		if limit < 1 || (maxLimit > 0 && limit > maxLimit) {
+			return paginator, NewValidationError(ValidationCodeOutOfRange, "limit", fmt.Sprintf("limit must be between 1 and %d", maxLimit))
+		}
+		paginator.Limit = limit
+	}
+
+	return paginator, nil
+}
+
+// ParseSort parses a sort expression like "name,-created_at" ("-" means descending).
+// Every field must be in allowed, so user input never reaches ORDER BY unchecked.
+//
+// Example:
+//
+//	sorts, err := helper.ParseSort(c.Query("sort"), []string{"name", "created_at"})
+func ParseSort(value string, allowed []string) ([]SortField, error) {
+	var sorts []SortField
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		field := SortField{Field: part}
+		if strings.HasPrefix(part, "-") {
+			field = SortField{Field: part[1:], Desc: true}
+		} else if strings.HasPrefix(part, "+") {
+			field.Field = part[1:]
+		}
+
+		if !isAllowedField(field.Field, allowed) {
+			return nil, NewValidationError(ValidationCodeNotAllowed, "sort", fmt.Sprintf("cannot sort by '%s', allowed fields: %s", field.Field, strings.Join(allowed, ", ")))
+		}
+		sorts = append(sorts, field)
+	}
+	return sorts, nil
+}
+
+// SortScope returns a GORM load scope applying the sort fields as ORDER BY.
+// Column names are quoted by GORM. It is wrapped with LoadScope, so it does not
+// affect the count query of PaginateGORMWithScopes.
+//
+// Example:
+//
+//	db.Scopes(helper.SortScope(sorts)).Find(&users)
+func SortScope(sorts []SortField) func(*gorm.DB) *gorm.DB {
+	return LoadScope(func(db *gorm.DB) *gorm.DB {
+		for _, field := range sorts {
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: field.Field}, Desc: field.Desc})
+		}
+		return db
+	})
+}
+
+// FilterScope returns a GORM filter scope from query parameters.
+// columns maps query parameter names to column names; only mapped parameters are used.
+// A repeated parameter (?status=a&status=b) becomes an IN condition.
+//
+// Example:
+//
+//	scope := helper.FilterScope(c, map[string]string{"status": "status", "role": "user_role"})
+//	db.Scopes(scope).Find(&users)
+func FilterScope(c *gin.Context, columns map[string]string) func(*gorm.DB) *gorm.DB {
+	params := make([]string, 0, len(columns))
+	for param := range columns {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+
+	query := c.Request.URL.Query()
+	conditions := make([]clause.Expression, 0, len(columns))
+	for _, param := range params {
+		column := columns[param]
+		values, ok := query[param]
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if len(values) == 1 {
+			conditions = append(conditions, clause.Eq{Column: clause.Column{Name: column}, Value: values[0]})
+			continue
+		}
+		in := make([]interface{}, len(values))
+		for i, v := range values {
+			in[i] = v
+		}
+		conditions = append(conditions, clause.IN{Column: clause.Column{Name: column}, Values: in})
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		for _, condition := range conditions {
+			db = db.Where(condition)
+		}
+		return db
+	}
+}
+
+// isAllowedField checks if field is in the allow-list
+func isAllowedField(field string, allowed []string) bool {
+	for _, a := range allowed {
+		if field == a {
+			return true
+		}
+	}
+	return false
+}
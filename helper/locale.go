@@ -0,0 +1,143 @@
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Context keys set by LocaleMiddleware
+const (
+	ContextKeyLocale   = "locale"
+	ContextKeyLocation = "location"
+)
+
+// DefaultLocale is used when a request has no usable Accept-Language header.
+var DefaultLocale = "th"
+
+// DefaultLocation returns the TZ location, or a fixed UTC+7 zone if it cannot be loaded.
+func DefaultLocation() *time.Location {
+	loc, err := time.LoadLocation(TZ)
+	if err != nil {
+		return time.FixedZone("UTC+7", 7*60*60)
+	}
+	return loc
+}
+
+// GetRequestLocale retrieves the locale resolved by LocaleMiddleware, or DefaultLocale.
+func GetRequestLocale(c *gin.Context) string {
+	if locale, ok := GetValue[string](c, ContextKeyLocale); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// PreferredLanguage returns the supported language that best matches the Accept-Language
+// header, or defaultLang when none matches.
+//
+// Languages are tried in descending q order (ties keep header order); entries with
+// q=0 or a malformed q are ignored. A tag matches a supported language exactly
+// (case-insensitive) or by primary subtag, so "en-US" matches "en" and "th" matches
+// "th-TH". "*" matches the first supported language. When supported is empty, the
+// highest-weighted tag is returned as sent.
+//
+// Example:
+//
+//	// Accept-Language: en;q=0.8, th;q=0.9
+//	lang := helper.PreferredLanguage(c, []string{"en", "th"}, "en") // Returns "th"
+//
+//	// Accept-Language: fr-CA, *;q=0.5
+//	lang = helper.PreferredLanguage(c, []string{"en", "th"}, "en")  // Returns "en"
+func PreferredLanguage(c *gin.Context, supported []string, defaultLang string) string {
+	for _, tag := range acceptLanguages(c.GetHeader("Accept-Language")) {
+		if len(supported) == 0 {
+			if tag != "*" {
+				return tag
+			}
+			continue
+		}
+		if tag == "*" {
+			return supported[0]
+		}
+		for _, lang := range supported {
+			if strings.EqualFold(tag, lang) {
+				return lang
+			}
+		}
+		for _, lang := range supported {
+			if strings.EqualFold(primarySubtag(tag), primarySubtag(lang)) {
+				return lang
+			}
+		}
+	}
+	return defaultLang
+}
+
+// acceptLanguages returns the tags of an Accept-Language header in descending q order
+func acceptLanguages(header string) []string {
+	type language struct {
+		tag string
+		q   float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil || parsed > 1 {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		languages = append(languages, language{tag: tag, q: q})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].q > languages[j].q
+	})
+	tags := make([]string, len(languages))
+	for i, l := range languages {
+		tags[i] = l.tag
+	}
+	return tags
+}
+
+// primarySubtag returns the language part of a tag, e.g. "en" for "en-US"
+func primarySubtag(tag string) string {
+	primary, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	return primary
+}
+
+// GetRequestLocation retrieves the time zone resolved by LocaleMiddleware, or DefaultLocation.
+func GetRequestLocation(c *gin.Context) *time.Location {
+	if loc, ok := GetValue[*time.Location](c, ContextKeyLocation); ok && loc != nil {
+		return loc
+	}
+	return DefaultLocation()
+}
+
+// TimestampInRequestLocation formats t with TimestampLayout in the request time zone.
+// Use it for response fields instead of Timestamp, whose JSON is always rendered in TZ.
+//
+// Example:
+//
+//	helper.SuccessResponse(c, 200, gin.H{
+//	    "created_at": helper.TimestampInRequestLocation(c, order.CreatedAt),
+//	})
+//	// With "X-Timezone: Asia/Tokyo": "2024-01-15 12:00:00+09"
+func TimestampInRequestLocation(c *gin.Context, t time.Time) string {
+	return t.In(GetRequestLocation(c)).Format(TimestampLayout)
+}
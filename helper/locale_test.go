@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPreferredLanguage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	supported := []string{"en", "th"}
+
+	tests := []struct {
+		name      string
+		header    string
+		supported []string
+		want      string
+	}{
+		{"q values", "en;q=0.8,th;q=0.9", supported, "th"},
+		{"ties keep header order", "th, en", supported, "th"},
+		{"region falls back to primary subtag", "en-US,fr;q=0.9", supported, "en"},
+		{"primary subtag matches region", "th", []string{"en-US", "th-TH"}, "th-TH"},
+		{"exact match before primary subtag", "en-GB", []string{"en-US", "en-GB"}, "en-GB"},
+		{"case-insensitive", "TH", supported, "th"},
+		{"underscore separator", "th_TH", supported, "th"},
+		{"no match", "de, fr", supported, "en-default"},
+		{"empty header", "", supported, "en-default"},
+
+		// Wildcards
+		{"wildcard only", "*", supported, "en"},
+		{"wildcard after unsupported", "fr-CA, *;q=0.5", supported, "en"},
+		{"supported before wildcard", "*;q=0.5, th;q=0.8", supported, "th"},
+		{"wildcard with no supported list", "*", nil, "en-default"},
+
+		// Malformed headers
+		{"garbage", ";;;,,,", supported, "en-default"},
+		{"malformed q ignored", "en;q=abc, th", supported, "th"},
+		{"q above 1 ignored", "en;q=2, th;q=0.1", supported, "th"},
+		{"q=0 is not acceptable", "th;q=0, en;q=0.1", supported, "en"},
+		{"empty entries", ",, th ,", supported, "th"},
+
+		{"no supported list returns the best tag", "fr-CA;q=0.9, de", nil, "de"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				c.Request.Header.Set("Accept-Language", tt.header)
+			}
+			if got := PreferredLanguage(c, tt.supported, "en-default"); got != tt.want {
+				t.Errorf("PreferredLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
@@ -22,10 +22,11 @@ func GetFileMimeType(buf io.Reader) (buffer bytes.Buffer, contentType string, ex
 
 // GetMimeType detects MIME type from reader and returns buffer, content type, extension, and error
 func GetMimeType(reader io.Reader) (*bytes.Buffer, string, string, error) {
-	// Read first 512 bytes to detect content type
+	// Read first 512 bytes to detect content type. A single Read may return
+	// fewer bytes, so fill the buffer; shorter inputs end with EOF.
 	peek := make([]byte, 512)
-	n, err := reader.Read(peek)
-	if err != nil && err != io.EOF {
+	n, err := io.ReadFull(reader, peek)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
 		return nil, "", "", err
 	}
 	peek = peek[:n]
@@ -48,6 +49,26 @@ func GetMimeType(reader io.Reader) (*bytes.Buffer, string, string, error) {
 	return buffer, contentType, extension, nil
 }
 
+// DetectFileTypeDeep detects the MIME type and extension of data from its magic bytes.
+// Unlike GetMimeType (http.DetectContentType) it recognizes office files inside zip
+// containers (docx, xlsx, pptx), webp, heic, mp4/mov brands and many other formats.
+// Unknown binary data falls back to http.DetectContentType.
+// Pass at least the first few KB of the file; office formats need their first zip entries.
+//
+// Example:
+//
+//	mimeType, ext := helper.DetectFileTypeDeep(data)
+//	// "application/vnd.openxmlformats-officedocument.wordprocessingml.document", ".docx"
+func DetectFileTypeDeep(data []byte) (mimeType string, extension string) {
+	detected := mimetype.Detect(data)
+	if !detected.Is("application/octet-stream") {
+		return detected.String(), detected.Extension()
+	}
+
+	mimeType = http.DetectContentType(data)
+	return mimeType, GetExtensionFromMimeType(mimeType)
+}
+
 // GetExtensionFromMimeType returns file extension from MIME type
 func GetExtensionFromMimeType(mimeType string) string {
 	// Remove charset if present
@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"testing/iotest"
+)
+
+func TestGetMimeTypeOneBytePerRead(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 2048)...)
+	pdf := []byte("%PDF-1.7\n")
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantType string
+		wantExt  string
+	}{
+		{"png over 512 bytes", png, "image/png", ".png"},
+		{"pdf under 512 bytes", pdf, "application/pdf", ".pdf"},
+		{"html", []byte("<!DOCTYPE html><html><body>hi</body></html>"), "text/html; charset=utf-8", ".html"},
+		{"empty", nil, "text/plain; charset=utf-8", ".txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer, contentType, ext, err := GetMimeType(iotest.OneByteReader(bytes.NewReader(tt.data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if contentType != tt.wantType {
+				t.Errorf("content type = %q, want %q", contentType, tt.wantType)
+			}
+			if ext != tt.wantExt {
+				t.Errorf("extension = %q, want %q", ext, tt.wantExt)
+			}
+			if !bytes.Equal(buffer.Bytes(), tt.data) {
+				t.Errorf("buffer has %d bytes, want all %d", buffer.Len(), len(tt.data))
+			}
+		})
+	}
+}
+
+func TestGetMimeTypeReadError(t *testing.T) {
+	errRead := errors.New("connection reset")
+	if _, _, _, err := GetMimeType(iotest.ErrReader(errRead)); !errors.Is(err, errRead) {
+		t.Errorf("err = %v, want %v", err, errRead)
+	}
+}
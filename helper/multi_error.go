@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MultiError collects several errors into one. The zero value is ready to use and
+// Add is safe for concurrent use. errors.Is and errors.As look through every error.
+//
+// Example:
+//
+//	var errs helper.MultiError
+//	for _, name := range objectNames {
+//	    errs.Add(client.RemoveObject("my-bucket", name))
+//	}
+//	if err := errs.ErrorOrNil(); err != nil {
+//	    log.Println(err)
+//	}
+type MultiError struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// Add appends err. Nil errors are ignored; a *MultiError is flattened into m.
+func (m *MultiError) Add(err error) {
+	if err == nil {
+		return
+	}
+	if other, ok := err.(*MultiError); ok {
+		if other == m {
+			return
+		}
+		for _, e := range other.Errors() {
+			m.Add(e)
+		}
+		return
+	}
+
+	m.mu.Lock()
+	m.errs = append(m.errs, err)
+	m.mu.Unlock()
+}
+
+// HasErrors reports whether any error was added.
+func (m *MultiError) HasErrors() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.errs) > 0
+}
+
+// Errors returns a copy of the collected errors.
+func (m *MultiError) Errors() []error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.errs) == 0 {
+		return nil
+	}
+	errs := make([]error, len(m.errs))
+	copy(errs, m.errs)
+	return errs
+}
+
+// ErrorOrNil returns m as an error, or nil when no error was added.
+// Return this instead of m so that an empty MultiError is a nil error.
+func (m *MultiError) ErrorOrNil() error {
+	if m == nil || !m.HasErrors() {
+		return nil
+	}
+	return m
+}
+
+// Error lists every error, one per line.
+//
+// Example output:
+//
+//	2 errors occurred:
+//	    * name: value is empty
+//	    * email: invalid email format
+func (m *MultiError) Error() string {
+	errs := m.Errors()
+	switch len(errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return errs[0].Error()
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d errors occurred:", len(errs))
+	for _, err := range errs {
+		sb.WriteString("\n    * ")
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the collected errors for errors.Is, errors.As and ValidationErrors.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors()
+}
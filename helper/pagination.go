@@ -52,6 +52,62 @@ func (p *Paginator) setTotalPages() {
 	p.TotalPages = int(totalPage)
 }
 
+// IsFirstPage reports whether the current page is the first page.
+func (p *Paginator) IsFirstPage() bool {
+	return p.Page <= 1
+}
+
+// IsLastPage reports whether the current page is the last page (also true when there are no pages).
+func (p *Paginator) IsLastPage() bool {
+	return p.Page >= p.TotalPages
+}
+
+// PageRange returns up to window page numbers around the current page for page-number controls,
+// clamped to [1, TotalPages]. The window is shifted near the first and last page so it stays full.
+// Rendering ellipses and first/last links is left to the caller.
+//
+// Example:
+//
+//	p := helper.Paginator{Page: 5, Limit: 10, TotalPages: 20}
+//	p.PageRange(5) // [3 4 5 6 7]
+//	p.Page = 1
+//	p.PageRange(5) // [1 2 3 4 5]
+//	p.Page = 20
+//	p.PageRange(5) // [16 17 18 19 20]
+func (p *Paginator) PageRange(window int) []int {
+	if p.TotalPages < 1 {
+		return []int{}
+	}
+	if window < 1 {
+		window = 1
+	}
+	if window > p.TotalPages {
+		window = p.TotalPages
+	}
+
+	current := p.Page
+	if current < 1 {
+		current = 1
+	}
+	if current > p.TotalPages {
+		current = p.TotalPages
+	}
+
+	start := current - (window-1)/2
+	if start < 1 {
+		start = 1
+	}
+	if end := start + window - 1; end > p.TotalPages {
+		start = p.TotalPages - window + 1
+	}
+
+	pages := make([]int, window)
+	for i := range pages {
+		pages[i] = start + i
+	}
+	return pages
+}
+
 // SetTotalFromMap sets pagination info from a map that contains a 'total' field.
 // Use this when scanning GORM rows into a map[string]any.
 //
@@ -138,3 +194,69 @@ func (p *Paginator) PaginateGORM(db *gorm.DB, dest any) error {
 	offset := (p.Page - 1) * p.Limit
 	return db.Offset(offset).Limit(p.Limit).Find(dest).Error
 }
+
+// paginationCountKey marks the count query run by PaginateGORMWithScopes
+const paginationCountKey = "helper:pagination_count"
+
+// LoadScope wraps a scope so it only applies to the data query of PaginateGORMWithScopes.
+// Use it for scopes that load related data (Preload, Joins for selecting columns) and
+// must not change or slow down the count query.
+//
+// Example:
+//
+//	withOrders := helper.LoadScope(func(db *gorm.DB) *gorm.DB {
+//	    return db.Preload("Orders", "status = ?", "paid")
+//	})
+func LoadScope(scope func(*gorm.DB) *gorm.DB) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if counting, _ := db.Get(paginationCountKey); counting == true {
+			return db
+		}
+		return scope(db)
+	}
+}
+
+// PreloadScope returns a LoadScope that preloads the given association.
+//
+// Example:
+//
+//	helper.PreloadScope("Orders.Items")
+func PreloadScope(query string, args ...interface{}) func(*gorm.DB) *gorm.DB {
+	return LoadScope(func(db *gorm.DB) *gorm.DB {
+		return db.Preload(query, args...)
+	})
+}
+
+// PaginateGORMWithScopes performs count and paginated query with GORM scopes.
+//
+// Scopes come in two kinds:
+//   - Filter scopes (plain scopes, e.g. Where) apply to both the count and the data query,
+//     so total_rows matches the filtered result
+//   - Load scopes (wrapped with LoadScope or built with PreloadScope) apply only to the
+//     data query, so preloading related data does not affect the count
+//
+// Example:
+//
+//	active := func(db *gorm.DB) *gorm.DB { return db.Where("active = ?", true) }
+//	paginator := helper.NewPaginatorWithParams(1, 20)
+//	var users []User
+//	err := paginator.PaginateGORMWithScopes(gormDB.Model(&User{}), &users,
+//	    active,
+//	    helper.PreloadScope("Orders"),
+//	)
+func (p *Paginator) PaginateGORMWithScopes(db *gorm.DB, dest any, scopes ...func(*gorm.DB) *gorm.DB) error {
+	if db.Statement.Model == nil && db.Statement.Table == "" {
+		db = db.Model(dest)
+	}
+
+	// Count total records with filter scopes only
+	var total int64
+	if err := db.Session(&gorm.Session{}).Set(paginationCountKey, true).Scopes(scopes...).Count(&total).Error; err != nil {
+		return err
+	}
+	p.SetPaginatorByAllRows(int(total))
+
+	// Apply pagination and query with all scopes
+	offset := (p.Page - 1) * p.Limit
+	return db.Session(&gorm.Session{}).Scopes(scopes...).Offset(offset).Limit(p.Limit).Find(dest).Error
+}
@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPaginatorPageRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       int
+		totalPages int
+		window     int
+		want       []int
+	}{
+		{"middle", 5, 20, 5, []int{3, 4, 5, 6, 7}},
+		{"first page", 1, 20, 5, []int{1, 2, 3, 4, 5}},
+		{"near start", 2, 20, 5, []int{1, 2, 3, 4, 5}},
+		{"near end", 19, 20, 5, []int{16, 17, 18, 19, 20}},
+		{"last page", 20, 20, 5, []int{16, 17, 18, 19, 20}},
+		{"even window", 5, 20, 4, []int{4, 5, 6, 7}},
+		{"fewer pages than window", 2, 3, 5, []int{1, 2, 3}},
+		{"single page", 1, 1, 5, []int{1}},
+		{"no pages", 1, 0, 5, []int{}},
+		{"window below 1", 4, 10, 0, []int{4}},
+		{"page past the end", 30, 10, 3, []int{8, 9, 10}},
+		{"page below 1", 0, 10, 3, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Paginator{Page: tt.page, Limit: 10, TotalPages: tt.totalPages}
+			if got := p.PageRange(tt.window); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PageRange(%d) = %v, want %v", tt.window, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginatorFirstAndLastPage(t *testing.T) {
+	tests := []struct {
+		page, totalPages int
+		first, last      bool
+	}{
+		{1, 5, true, false},
+		{3, 5, false, false},
+		{5, 5, false, true},
+		{1, 1, true, true},
+		{1, 0, true, true},
+		{0, 5, true, false},
+	}
+
+	for _, tt := range tests {
+		p := Paginator{Page: tt.page, Limit: 10, TotalPages: tt.totalPages}
+		if got := p.IsFirstPage(); got != tt.first {
+			t.Errorf("page %d/%d: IsFirstPage() = %v, want %v", tt.page, tt.totalPages, got, tt.first)
+		}
+		if got := p.IsLastPage(); got != tt.last {
+			t.Errorf("page %d/%d: IsLastPage() = %v, want %v", tt.page, tt.totalPages, got, tt.last)
+		}
+	}
+}
+
+func TestPaginatorSetPaginatorByAllRows(t *testing.T) {
+	p := NewPaginatorWithParams(2, 10)
+	p.SetPaginatorByAllRows(95)
+	if p.TotalPages != 10 || p.TotalEntrySizes != 95 {
+		t.Fatalf("TotalPages = %d, TotalEntrySizes = %d", p.TotalPages, p.TotalEntrySizes)
+	}
+	if got := p.PageRange(3); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("PageRange(3) = %v", got)
+	}
+}
@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/convert"
+	"github.com/gin-gonic/gin"
+)
+
+// ParamPath returns the value at a dotted path in the parsed request params
+// (stored by the InputForm middleware), or def if any part of the path is missing or nil.
+// Numeric segments index into arrays.
+//
+// Example:
+//
+//	// params: {"address": {"city": "Bangkok"}, "items": [{"sku": "A1"}]}
+//	city := helper.ParamPath(c, "address.city", "")    // "Bangkok"
+//	sku := helper.ParamPath(c, "items.0.sku", nil)     // "A1"
+//	zip := helper.ParamPath(c, "address.zip", "10110") // "10110"
+func ParamPath(c *gin.Context, path string, def interface{}) interface{} {
+	params, ok := GetValue[map[string]interface{}](c, ContextKeyParams)
+	if !ok {
+		return def
+	}
+
+	var current interface{} = params
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[segment]
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return def
+			}
+			current = node[index]
+		default:
+			return def
+		}
+		if current == nil {
+			return def
+		}
+	}
+	return current
+}
+
+// ParamPathString returns the value at a dotted params path as a string, or def if it is missing
+func ParamPathString(c *gin.Context, path string, def string) string {
+	value := ParamPath(c, path, nil)
+	if value == nil {
+		return def
+	}
+	return convert.ToString(value)
+}
+
+// ParamPathInt returns the value at a dotted params path as an int, or def if it is missing or not a number
+//
+// Example:
+//
+//	page := helper.ParamPathInt(c, "page", 1)
+//	qty := helper.ParamPathInt(c, "items.0.qty", 0)
+func ParamPathInt(c *gin.Context, path string, def int) int {
+	value := ParamPath(c, path, nil)
+	if value == nil {
+		return def
+	}
+	n, err := convert.ToInt(value)
+	if err != nil {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool processes inputs with at most concurrency workers and returns results in input order.
+//
+// outputs[i] and errs[i] belong to inputs[i]. errs is nil when every call succeeded.
+// When ctx is canceled no new inputs are started; inputs that were never started get ctx.Err().
+// A concurrency below 1 runs one worker.
+//
+// Example:
+//
+//	sizes, errs := helper.Pool(ctx, objectNames, 8, func(ctx context.Context, name string) (int64, error) {
+//	    info, err := client.GetClient().StatObject(ctx, "my-bucket", name, minio.StatObjectOptions{})
+//	    return info.Size, err
+//	})
+//	for i, err := range errs {
+//	    if err != nil {
+//	        log.Printf("%s: %v", objectNames[i], err)
+//	    }
+//	}
+func Pool[In any, Out any](ctx context.Context, inputs []In, concurrency int, fn func(context.Context, In) (Out, error)) ([]Out, []error) {
+	outputs := make([]Out, len(inputs))
+	errs := make([]error, len(inputs))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(inputs) {
+		concurrency = len(inputs)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				outputs[i], errs[i] = fn(ctx, inputs[i])
+			}
+		}()
+	}
+
+	next := 0
+submit:
+	for ; next < len(inputs) && ctx.Err() == nil; next++ {
+		select {
+		case <-ctx.Done():
+			break submit
+		case jobs <- next:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i := next; i < len(inputs); i++ {
+		errs[i] = ctx.Err()
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return outputs, errs
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,106 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPoolPreservesOrder(t *testing.T) {
+	inputs := []int{5, 1, 4, 2, 3}
+	outputs, errs := Pool(context.Background(), inputs, 3, func(ctx context.Context, n int) (string, error) {
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return fmt.Sprint(n * 10), nil
+	})
+
+	if errs != nil {
+		t.Fatalf("errs = %v, want nil", errs)
+	}
+	for i, n := range inputs {
+		if want := fmt.Sprint(n * 10); outputs[i] != want {
+			t.Errorf("outputs[%d] = %q, want %q", i, outputs[i], want)
+		}
+	}
+}
+
+func TestPoolBoundsConcurrency(t *testing.T) {
+	var running, peak int32
+	inputs := make([]int, 20)
+
+	Pool(context.Background(), inputs, 4, func(ctx context.Context, _ int) (struct{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return struct{}{}, nil
+	})
+
+	if peak > 4 {
+		t.Errorf("peak concurrency = %d, want at most 4", peak)
+	}
+}
+
+func TestPoolAggregatesErrors(t *testing.T) {
+	errOdd := errors.New("odd")
+	outputs, errs := Pool(context.Background(), []int{1, 2, 3, 4}, 2, func(ctx context.Context, n int) (int, error) {
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+		return n * n, nil
+	})
+
+	if len(errs) != 4 {
+		t.Fatalf("len(errs) = %d, want 4", len(errs))
+	}
+	for i, want := range []error{errOdd, nil, errOdd, nil} {
+		if errs[i] != want {
+			t.Errorf("errs[%d] = %v, want %v", i, errs[i], want)
+		}
+	}
+	if outputs[1] != 4 || outputs[3] != 16 {
+		t.Errorf("outputs = %v, want successful results kept", outputs)
+	}
+}
+
+func TestPoolCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var started int32
+
+	_, errs := Pool(ctx, make([]int, 100), 2, func(ctx context.Context, _ int) (int, error) {
+		if atomic.AddInt32(&started, 1) == 3 {
+			cancel()
+		}
+		return 0, nil
+	})
+
+	if n := atomic.LoadInt32(&started); n >= 100 {
+		t.Fatalf("started = %d, want submission to stop after cancel", n)
+	}
+	var canceled int
+	for _, err := range errs {
+		if errors.Is(err, context.Canceled) {
+			canceled++
+		}
+	}
+	if canceled == 0 || canceled+int(atomic.LoadInt32(&started)) != 100 {
+		t.Errorf("canceled = %d, started = %d; every input must be started or get ctx.Err()", canceled, started)
+	}
+}
+
+func TestPoolEmptyInputs(t *testing.T) {
+	outputs, errs := Pool(context.Background(), nil, 4, func(ctx context.Context, n int) (int, error) {
+		t.Error("fn must not be called")
+		return 0, nil
+	})
+	if len(outputs) != 0 || errs != nil {
+		t.Errorf("outputs = %v, errs = %v", outputs, errs)
+	}
+}
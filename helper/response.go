@@ -1,34 +1,75 @@
 package helper
 
 import (
+	"sort"
+	"time"
+
 	"github.com/gin-gonic/gin"
 )
 
+// ContextKeyRequestStart is the Gin context key for the request start time set by ResponseTimingMiddleware
+const ContextKeyRequestStart = "request_start"
+
+// IncludeResponseTiming adds "took_ms" and "request_id" to the meta block of every response
+// sent by this package, for debugging. It is off by default so production responses stay lean;
+// set it once at startup and apply middleware.ResponseTimingMiddleware.
+var IncludeResponseTiming = false
+
 // Response represents a standard API response
 type Response struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Error   *ErrorInfo  `json:"error,omitempty"`
+	Success bool                   `json:"success"`
+	Data    interface{}            `json:"data,omitempty"`
+	Meta    map[string]interface{} `json:"meta,omitempty"`
+	Error   *ErrorInfo             `json:"error,omitempty"`
 }
 
 // ErrorInfo represents error information
 type ErrorInfo struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
+	Code    string             `json:"code"`
+	Message string             `json:"message"`
+	Details string             `json:"details,omitempty"`
+	Fields  map[string]string  `json:"fields,omitempty"`
+	Errors  []*ValidationError `json:"errors,omitempty"`
+}
+
+// writeResponse sends resp as JSON, adding timing metadata when IncludeResponseTiming is set
+func writeResponse(c *gin.Context, statusCode int, resp Response) {
+	if IncludeResponseTiming {
+		if start, ok := GetValue[time.Time](c, ContextKeyRequestStart); ok {
+			meta := make(map[string]interface{}, len(resp.Meta)+2)
+			for k, v := range resp.Meta {
+				meta[k] = v
+			}
+			meta["took_ms"] = float64(time.Since(start).Microseconds()) / 1000
+			if requestID := c.GetString(ContextKeyRequestID); requestID != "" {
+				meta["request_id"] = requestID
+			}
+			resp.Meta = meta
+		}
+	}
+	c.JSON(statusCode, resp)
 }
 
 // SuccessResponse sends a success response
 func SuccessResponse(c *gin.Context, statusCode int, data interface{}) {
-	c.JSON(statusCode, Response{
+	writeResponse(c, statusCode, Response{
 		Success: true,
 		Data:    data,
 	})
 }
 
+// SuccessResponseWithMeta sends a success response with a meta object alongside data
+func SuccessResponseWithMeta(c *gin.Context, statusCode int, data interface{}, meta map[string]interface{}) {
+	writeResponse(c, statusCode, Response{
+		Success: true,
+		Data:    data,
+		Meta:    meta,
+	})
+}
+
 // ErrorResponse sends an error response
 func ErrorResponse(c *gin.Context, statusCode int, code, message string) {
-	c.JSON(statusCode, Response{
+	writeResponse(c, statusCode, Response{
 		Success: false,
 		Error: &ErrorInfo{
 			Code:    code,
@@ -37,14 +78,75 @@ func ErrorResponse(c *gin.Context, statusCode int, code, message string) {
 	})
 }
 
-// ValidationErrorResponse sends a validation error response
+// ValidationErrorResponse sends a validation error response.
+// Any *ValidationError in err (also inside errors.Join) is listed in error.errors with its code and field.
 func ValidationErrorResponse(c *gin.Context, err error) {
-	c.JSON(400, Response{
+	writeResponse(c, 400, Response{
 		Success: false,
 		Error: &ErrorInfo{
 			Code:    "VALIDATION_ERROR",
 			Message: "Validation failed",
 			Details: err.Error(),
+			Errors:  ValidationErrors(err),
+		},
+	})
+}
+
+// RespondValidationMap sends a validation error response with a field -> message object.
+// Any *ValidationError values are also listed in error.errors with their code and field.
+//
+// Example:
+//
+//	errs := helper.ValidateKeyExists([]string{"name", "email"}, params)
+//	helper.RespondValidationMap(c, errs)
+//	// {"success":false,"error":{"code":"VALIDATION_ERROR","message":"Validation failed",
+//	//   "fields":{"email":"key 'email' not exists"}, ...}}
+func RespondValidationMap(c *gin.Context, errs map[string]error) {
+	fields := make(map[string]string, len(errs))
+	keys := make([]string, 0, len(errs))
+	for field, err := range errs {
+		if err == nil {
+			continue
+		}
+		fields[field] = err.Error()
+		keys = append(keys, field)
+	}
+	sort.Strings(keys)
+
+	var validationErrors []*ValidationError
+	for _, field := range keys {
+		for _, ve := range ValidationErrors(errs[field]) {
+			if ve.Field == "" {
+				ve = ve.WithField(field)
+			}
+			validationErrors = append(validationErrors, ve)
+		}
+	}
+
+	writeResponse(c, 400, Response{
+		Success: false,
+		Error: &ErrorInfo{
+			Code:    "VALIDATION_ERROR",
+			Message: "Validation failed",
+			Fields:  fields,
+			Errors:  validationErrors,
 		},
 	})
 }
+
+// RespondIfValidationMap sends RespondValidationMap and returns true when errs has any error.
+//
+// Example:
+//
+//	if helper.RespondIfValidationMap(c, helper.ValidateKeyExists(required, params)) {
+//	    return
+//	}
+func RespondIfValidationMap(c *gin.Context, errs map[string]error) bool {
+	for _, err := range errs {
+		if err != nil {
+			RespondValidationMap(c, errs)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryOption configures Retry.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	retryIf  func(error) bool
+	jitter   bool
+	maxDelay time.Duration
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// RetryIf only retries errors for which retryable returns true.
+// Other errors are returned immediately.
+func RetryIf(retryable func(error) bool) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.retryIf = retryable
+	}
+}
+
+// RetryWithJitter enables full jitter: each delay is random between 0 and the backoff.
+func RetryWithJitter() RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.jitter = true
+	}
+}
+
+// RetryMaxDelay caps the delay between attempts.
+func RetryMaxDelay(d time.Duration) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.maxDelay = d
+	}
+}
+
+// RetrySleep replaces the function used to wait between attempts (useful in tests).
+// It must return an error when ctx is done.
+func RetrySleep(sleep func(ctx context.Context, d time.Duration) error) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.sleep = sleep
+	}
+}
+
+// Retry calls fn up to attempts times with exponential backoff (backoff, 2*backoff, 4*backoff, ...).
+// It stops early when fn succeeds, when ctx is canceled, or when RetryIf rejects the error.
+// The returned error wraps the last error from fn together with the attempt count.
+//
+// Example:
+//
+//	err := helper.Retry(ctx, 5, 200*time.Millisecond, func() error {
+//	    return client.Ping()
+//	}, helper.RetryWithJitter(), helper.RetryIf(func(err error) bool {
+//	    return !errors.Is(err, ErrUnauthorized)
+//	}))
+func Retry(ctx context.Context, attempts int, backoff time.Duration, fn func() error, opts ...RetryOption) error {
+	cfg := retryConfig{sleep: sleepContext}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return retryError(attempt-1, err, ctxErr)
+		}
+
+		if err = fn(); err == nil {
+			return nil
+		}
+		if cfg.retryIf != nil && !cfg.retryIf(err) {
+			return retryError(attempt, err, nil)
+		}
+		if attempt == attempts {
+			break
+		}
+
+		if sleepErr := cfg.sleep(ctx, cfg.delay(backoff, attempt)); sleepErr != nil {
+			return retryError(attempt, err, sleepErr)
+		}
+	}
+	return retryError(attempts, err, nil)
+}
+
+// delay returns the wait before the next attempt
+func (cfg retryConfig) delay(backoff time.Duration, attempt int) time.Duration {
+	d := backoff
+	for i := 1; i < attempt && d > 0 && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if cfg.maxDelay > 0 && d > cfg.maxDelay {
+		d = cfg.maxDelay
+	}
+	if cfg.jitter && d > 0 {
+		d = time.Duration(rand.Int63n(int64(d) + 1))
+	}
+	return d
+}
+
+// retryError wraps the last error and the reason retrying stopped
+func retryError(attempts int, last error, stopped error) error {
+	if last == nil {
+		return stopped
+	}
+	if stopped != nil {
+		return fmt.Errorf("retry stopped after %d attempts: %w", attempts, errors.Join(last, stopped))
+	}
+	return fmt.Errorf("failed after %d attempts: %w", attempts, last)
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordSleep returns a RetrySleep option that records delays instead of waiting
+func recordSleep(delays *[]time.Duration) RetryOption {
+	return RetrySleep(func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return ctx.Err()
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	var delays []time.Duration
+	calls := 0
+
+	err := Retry(context.Background(), 4, 100*time.Millisecond, func() error {
+		calls++
+		return errTemporary
+	}, recordSleep(&delays))
+
+	if calls != 4 {
+		t.Errorf("calls = %d, want 4", calls)
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	if len(delays) != len(want) {
+		t.Fatalf("delays = %v, want %v", delays, want)
+	}
+	for i := range want {
+		if delays[i] != want[i] {
+			t.Errorf("delays[%d] = %v, want %v", i, delays[i], want[i])
+		}
+	}
+	if !errors.Is(err, errTemporary) {
+		t.Errorf("err = %v, want wrapping %v", err, errTemporary)
+	}
+	if !strings.Contains(err.Error(), "4 attempts") {
+		t.Errorf("err = %q, want the attempt count", err)
+	}
+}
+
+func TestRetrySucceeds(t *testing.T) {
+	var delays []time.Duration
+	calls := 0
+
+	err := Retry(context.Background(), 5, time.Second, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, recordSleep(&delays))
+
+	if err != nil || calls != 3 || len(delays) != 2 {
+		t.Errorf("err = %v, calls = %d, sleeps = %d; want nil, 3, 2", err, calls, len(delays))
+	}
+}
+
+func TestRetryMaxDelayAndJitter(t *testing.T) {
+	var delays []time.Duration
+	Retry(context.Background(), 5, time.Second, func() error {
+		return errors.New("fail")
+	}, RetryMaxDelay(3*time.Second), recordSleep(&delays))
+
+	want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second}
+	for i := range want {
+		if delays[i] != want[i] {
+			t.Errorf("delays[%d] = %v, want %v", i, delays[i], want[i])
+		}
+	}
+
+	delays = nil
+	Retry(context.Background(), 5, time.Second, func() error {
+		return errors.New("fail")
+	}, RetryWithJitter(), recordSleep(&delays))
+
+	for i, d := range delays {
+		if limit := time.Second << i; d < 0 || d > limit {
+			t.Errorf("jittered delays[%d] = %v, want within [0, %v]", i, d, limit)
+		}
+	}
+}
+
+func TestRetryIf(t *testing.T) {
+	errPermanent := errors.New("permanent")
+	var delays []time.Duration
+	calls := 0
+
+	err := Retry(context.Background(), 5, time.Second, func() error {
+		calls++
+		return errPermanent
+	}, RetryIf(func(err error) bool { return !errors.Is(err, errPermanent) }), recordSleep(&delays))
+
+	if calls != 1 || len(delays) != 0 {
+		t.Errorf("calls = %d, sleeps = %d; want 1, 0", calls, len(delays))
+	}
+	if !errors.Is(err, errPermanent) {
+		t.Errorf("err = %v, want wrapping %v", err, errPermanent)
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errTemporary := errors.New("temporary")
+	calls := 0
+
+	err := Retry(ctx, 10, time.Second, func() error {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return errTemporary
+	}, RetrySleep(func(ctx context.Context, d time.Duration) error { return ctx.Err() }))
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errTemporary) {
+		t.Errorf("err = %v, want wrapping context.Canceled and the last error", err)
+	}
+
+	calls = 0
+	err = Retry(ctx, 3, time.Second, func() error {
+		calls++
+		return nil
+	})
+	if calls != 0 || !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled before start: calls = %d, err = %v", calls, err)
+	}
+}
+
+func TestRetryRealSleep(t *testing.T) {
+	start := time.Now()
+	calls := 0
+	Retry(context.Background(), 3, 10*time.Millisecond, func() error {
+		calls++
+		return errors.New("fail")
+	})
+	// 10ms + 20ms between the three attempts
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("elapsed = %v, want at least 30ms", elapsed)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// compiledSchemas caches compiled JSON schemas keyed by their source
+var compiledSchemas sync.Map
+
+// ValidateJSONSchema validates a JSON document against a JSON Schema.
+// The schema is compiled on first use and cached for subsequent calls.
+// On failure the returned error lists every failing field path, e.g.
+// "/address/zip: missing properties: 'zip'; /age: expected integer, but got string".
+func ValidateJSONSchema(body []byte, schema string) error {
+	sch, err := compileJSONSchema(schema)
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+
+	if err := sch.Validate(doc); err != nil {
+		var ve *jsonschema.ValidationError
+		if errors.As(err, &ve) {
+			return errors.New(strings.Join(schemaErrorFields(ve), "; "))
+		}
+		return err
+	}
+	return nil
+}
+
+// compileJSONSchema compiles the schema or returns it from the cache
+func compileJSONSchema(schema string) (*jsonschema.Schema, error) {
+	if sch, ok := compiledSchemas.Load(schema); ok {
+		return sch.(*jsonschema.Schema), nil
+	}
+	sch, err := jsonschema.CompileString("schema.json", schema)
+	if err != nil {
+		return nil, err
+	}
+	compiledSchemas.Store(schema, sch)
+	return sch, nil
+}
+
+// schemaErrorFields flattens a validation error into "path: message" entries
+func schemaErrorFields(ve *jsonschema.ValidationError) []string {
+	if len(ve.Causes) == 0 {
+		path := ve.InstanceLocation
+		if path == "" {
+			path = "/"
+		}
+		return []string{path + ": " + ve.Message}
+	}
+	var fields []string
+	for _, cause := range ve.Causes {
+		fields = append(fields, schemaErrorFields(cause)...)
+	}
+	return fields
+}
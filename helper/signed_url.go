@@ -0,0 +1,42 @@
+package helper
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Query parameters used by SignPath
+const (
+	SignedURLExpiryParam    = "exp"
+	SignedURLSignatureParam = "sig"
+)
+
+// SignPath returns path with an expiry and HMAC-SHA256 signature appended (?exp=...&sig=...).
+// Verify it with middleware.SignedURLGuardMiddleware using the same secret.
+//
+// path is the unescaped URL path; it is escaped in the result. Only the path and expiry
+// are signed, so do not rely on other query parameters added to the link.
+//
+// Example:
+//
+//	link := helper.SignPath("/files/reports/2024.pdf", time.Now().Add(15*time.Minute), secret)
+//	// /files/reports/2024.pdf?exp=1705277700&sig=3f1a...
+func SignPath(path string, exp time.Time, secret string) string {
+	expiry := strconv.FormatInt(exp.Unix(), 10)
+	query := url.Values{}
+	query.Set(SignedURLExpiryParam, expiry)
+	query.Set(SignedURLSignatureParam, SignHMACSHA256(secret, signedPathPayload(path, expiry)))
+	return (&url.URL{Path: path}).EscapedPath() + "?" + query.Encode()
+}
+
+// VerifySignedPath checks the signature of an unescaped path and expiry in constant time.
+// It does not check whether the expiry has passed.
+func VerifySignedPath(path string, expiry string, signature string, secret string) bool {
+	return VerifyHMACSHA256(secret, signedPathPayload(path, expiry), signature)
+}
+
+// signedPathPayload is the signed message: path and expiry separated by a newline
+func signedPathPayload(path string, expiry string) []byte {
+	return []byte(path + "\n" + expiry)
+}
@@ -0,0 +1,169 @@
+package helper
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StreamJSONArray streams a JSON array to the client one element at a time.
+//
+// produce is called once and must call yield for every element; each element is
+// written and flushed immediately, so memory use does not grow with the result size.
+// The response uses chunked transfer encoding. Set a Content-Disposition header
+// before calling if the array should be downloaded as a file.
+//
+// Limitation: the status and headers are sent with the first byte, so an error
+// returned by produce mid-stream cannot change the response. The array is left
+// unterminated (invalid JSON) so clients can detect the failure, and the error is returned.
+//
+// Example:
+//
+//	err := helper.StreamJSONArray(c, func(yield func(interface{}) error) error {
+//	    rows, err := db.Model(&User{}).Rows()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    defer rows.Close()
+//	    for rows.Next() {
+//	        var u User
+//	        db.ScanRows(rows, &u)
+//	        if err := yield(u); err != nil {
+//	            return err
+//	        }
+//	    }
+//	    return nil
+//	})
+func StreamJSONArray(c *gin.Context, produce func(yield func(interface{}) error) error) error {
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	if _, err := c.Writer.WriteString("["); err != nil {
+		return err
+	}
+
+	first := true
+	yield := func(item interface{}) error {
+		bu, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if !first {
+			if _, err := c.Writer.WriteString(","); err != nil {
+				return err
+			}
+		}
+		first = false
+		if _, err := c.Writer.Write(bu); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	if err := produce(yield); err != nil {
+		c.Writer.Flush()
+		return err
+	}
+
+	if _, err := c.Writer.WriteString("]"); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
+
+// StreamCSV streams CSV rows to the client as a file download.
+//
+// The header row is written first (skipped when empty), then produce must call
+// yield for every row. filename is sent with AttachmentDisposition, so quotes and
+// non-ASCII names are safe. Rows are flushed immediately using chunked transfer encoding.
+//
+// Limitation: as with StreamJSONArray, an error returned by produce mid-stream
+// cannot change the already-sent status; the file is simply cut short and the error is returned.
+//
+// Example:
+//
+//	err := helper.StreamCSV(c, "users.csv", []string{"id", "name"}, func(yield func([]string) error) error {
+//	    for _, u := range users {
+//	        if err := yield([]string{u.ID.String(), u.Name}); err != nil {
+//	            return err
+//	        }
+//	    }
+//	    return nil
+//	})
+func StreamCSV(c *gin.Context, filename string, header []string, produce func(yield func([]string) error) error) error {
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", AttachmentDisposition(filename))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	yield := func(record []string) error {
+		if err := w.Write(record); err != nil {
+			return err
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	if len(header) > 0 {
+		if err := yield(header); err != nil {
+			return err
+		}
+	}
+
+	return produce(yield)
+}
+
+// AttachmentDisposition builds a Content-Disposition attachment header for filename.
+// ASCII names are quoted as is; other names get an ASCII fallback plus the RFC 5987
+// filename* parameter, which browsers prefer.
+//
+// Example:
+//
+//	helper.AttachmentDisposition("report.pdf")
+//	// attachment; filename="report.pdf"
+//	helper.AttachmentDisposition("ใบเสนอราคา.pdf")
+//	// attachment; filename="__________.pdf"; filename*=UTF-8''%E0%B9%83...
+func AttachmentDisposition(filename string) string {
+	fallback := asciiFilename(filename)
+	disposition := `attachment; filename="` + fallback + `"`
+	if fallback != filename {
+		disposition += "; filename*=UTF-8''" + rfc5987Escape(filename)
+	}
+	return disposition
+}
+
+// asciiFilename replaces characters that cannot appear in a quoted ASCII file name
+func asciiFilename(filename string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e || r == '"' || r == '\\' {
+			return '_'
+		}
+		return r
+	}, filename)
+}
+
+// rfc5987Escape percent-encodes every byte outside the RFC 5987 attr-char set
+func rfc5987Escape(s string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' || strings.IndexByte("!#$&+-.^_`|~", b) >= 0 {
+			sb.WriteByte(b)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[b>>4])
+		sb.WriteByte(hex[b&0x0f])
+	}
+	return sb.String()
+}
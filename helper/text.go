@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// utf8BOM is the UTF-8 byte order mark written by some Windows editors
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// StripBOM returns a reader that skips a leading UTF-8 byte order mark, if present.
+//
+// Example:
+//
+//	reader := csv.NewReader(helper.StripBOM(file))
+//	records, err := reader.ReadAll()
+func StripBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if prefix, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+		_, _ = br.Discard(len(utf8BOM))
+	}
+	return br
+}
+
+// NormalizeLineEndings converts CRLF and lone CR line endings to LF.
+//
+// Example:
+//
+//	data = helper.NormalizeLineEndings([]byte("a\r\nb\rc")) // Returns "a\nb\nc"
+func NormalizeLineEndings(data []byte) []byte {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	return bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+}
+
+// NormalizeText strips a leading UTF-8 BOM and normalizes line endings to LF.
+func NormalizeText(data []byte) []byte {
+	return NormalizeLineEndings(bytes.TrimPrefix(data, utf8BOM))
+}
@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 test key of RFC 6238 ("12345678901234567890") in base32
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// rfc6238Vectors are the SHA1 test vectors of RFC 6238 Appendix B (8 digits)
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "94287082"},
+	{1111111109, "07081804"},
+	{1111111111, "14050471"},
+	{1234567890, "89005924"},
+	{2000000000, "69279037"},
+	{20000000000, "65353130"},
+}
+
+func TestTOTPRFC6238Vectors(t *testing.T) {
+	key, err := decodeTOTPSecret(rfc6238Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(key) != "12345678901234567890" {
+		t.Fatalf("decoded key = %q", key)
+	}
+
+	for _, v := range rfc6238Vectors {
+		at := time.Unix(v.unix, 0).UTC()
+		if got := totpCode(key, totpCounter(at), 8); got != v.code {
+			t.Errorf("T=%d: 8-digit code = %s, want %s", v.unix, got, v.code)
+		}
+		// Six-digit codes are the last six digits of the same value
+		if got, want := TOTPAt(rfc6238Secret, at), v.code[2:]; got != want {
+			t.Errorf("T=%d: TOTPAt = %s, want %s", v.unix, got, want)
+		}
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code := TOTPAt(rfc6238Secret, now)
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		skew   int
+		want   bool
+	}{
+		{"same step", 0, 0, true},
+		{"adjacent step without skew", -TOTPPeriod, 0, false},
+		{"previous step with skew 1", TOTPPeriod, 1, true},
+		{"next step with skew 1", -TOTPPeriod, 1, true},
+		{"two steps with skew 1", 2 * TOTPPeriod, 1, false},
+		{"two steps with skew 2", 2 * TOTPPeriod, 2, true},
+		{"negative skew is zero", TOTPPeriod, -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateTOTPAt(rfc6238Secret, code, tt.skew, now.Add(tt.offset)); got != tt.want {
+				t.Errorf("validateTOTPAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPRejectsMalformedInput(t *testing.T) {
+	now := time.Unix(59, 0)
+	for _, code := range []string{"", "28708", "2870820", "abcdef", "94287082"} {
+		if validateTOTPAt(rfc6238Secret, code, 1, now) {
+			t.Errorf("code %q must be rejected", code)
+		}
+	}
+	if !validateTOTPAt(rfc6238Secret, " 287082 ", 0, now) {
+		t.Error("surrounding spaces must be ignored")
+	}
+	if validateTOTPAt("not base32!", "287082", 1, now) || TOTPAt("not base32!", now) != "" {
+		t.Error("an invalid secret must never validate")
+	}
+	if got := TOTPAt(strings.ToLower("GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ"), now); got != "287082" {
+		t.Errorf("lowercase spaced secret: TOTPAt = %q, want 287082", got)
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret := GenerateTOTPSecret()
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(key) != 20 {
+		t.Fatalf("secret %q decodes to %d bytes, err %v", secret, len(key), err)
+	}
+	if GenerateTOTPSecret() == secret {
+		t.Error("secrets must be random")
+	}
+	if code := TOTPNow(secret); len(code) != TOTPDigits || !ValidateTOTP(secret, code, 1) {
+		t.Errorf("TOTPNow code %q does not validate", code)
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("gezdgnbv", "My App", "john@example.com")
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/My App:john@example.com" {
+		t.Errorf("uri = %s", uri)
+	}
+	if strings.Contains(uri, "+") {
+		t.Errorf("spaces must be encoded as %%20: %s", uri)
+	}
+	q := u.Query()
+	if q.Get("secret") != "GEZDGNBV" || q.Get("issuer") != "My App" || q.Get("digits") != "6" || q.Get("period") != "30" || q.Get("algorithm") != "SHA1" {
+		t.Errorf("query = %v", q)
+	}
+}
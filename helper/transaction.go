@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ContextKeyTx is the Gin context key for the transaction started by TransactionMiddleware
+const ContextKeyTx = "db_tx"
+
+// GetTx retrieves the request transaction stored by TransactionMiddleware
+//
+// Example:
+//
+//	tx, ok := helper.GetTx(c)
+//	if !ok {
+//	    helper.ErrorResponse(c, 500, "INTERNAL_SERVER_ERROR", "No transaction")
+//	    return
+//	}
+//	tx.Create(&order)
+func GetTx(c *gin.Context) (*gorm.DB, bool) {
+	tx, ok := GetValue[*gorm.DB](c, ContextKeyTx)
+	return tx, ok && tx != nil
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/oklog/ulid/v2"
+)
+
+// NewULID generates a new ULID string (monotonic within the same millisecond)
+func NewULID() string {
+	return ulid.Make().String()
+}
+
+// GetULIDFromContext retrieves a ULID stored in context under key.
+// Accepts both ulid.ULID values and ULID strings.
+func GetULIDFromContext(c *gin.Context, key string) (ulid.ULID, bool) {
+	value, exists := c.Get(key)
+	if !exists {
+		return ulid.ULID{}, false
+	}
+
+	switch v := value.(type) {
+	case ulid.ULID:
+		return v, true
+	case string:
+		id, err := ulid.ParseStrict(v)
+		return id, err == nil
+	}
+	return ulid.ULID{}, false
+}
+
+// GetUserULIDFromContext retrieves user ID from context when it is a ULID
+func GetUserULIDFromContext(c *gin.Context) (ulid.ULID, bool) {
+	return GetULIDFromContext(c, ContextKeyUserID)
+}
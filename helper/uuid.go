@@ -0,0 +1,38 @@
+package helper
+
+import "github.com/google/uuid"
+
+// ShortUUID returns the first 8 characters of id, for compact log output
+//
+// Example:
+//
+//	helper.ShortUUID(userID) // "550e8400"
+func ShortUUID(id uuid.UUID) string {
+	return id.String()[:8]
+}
+
+// MaskUUID hides the middle of id while keeping its shape, for logs that must not expose full IDs
+//
+// Example:
+//
+//	helper.MaskUUID(userID) // "550e8400-****-****-****-********0000"
+func MaskUUID(id uuid.UUID) string {
+	s := id.String()
+	return s[:8] + "-****-****-****-********" + s[32:]
+}
+
+// ParseUUIDOrNil parses s as a UUID, returning uuid.Nil for invalid input
+//
+// Example:
+//
+//	if userID := helper.ParseUUIDOrNil(c.Param("id")); userID == uuid.Nil {
+//	    helper.ErrorResponse(c, 400, "INVALID_ID", "Invalid user ID")
+//	    return
+//	}
+func ParseUUIDOrNil(s string) uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
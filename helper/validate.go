@@ -1,17 +1,22 @@
 package helper
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
 )
 
 // ValidateKeyExists checks if all specified keys exist in the params map.
 // Returns a map of errors for each missing key, or nil if all keys exist.
+//
+// All Validate* functions return *ValidationError, so callers can use errors.As
+// to read the machine-readable Code (e.g. ValidationCodeNotUUID). Validators that
+// receive only a value leave Field empty; call them through ValidateField (or use
+// WithField) to report the field name.
 func ValidateKeyExists(keys []string, params map[string]interface{}) map[string]error {
 	if len(keys) == 0 || params == nil {
 		return nil
@@ -21,7 +26,7 @@ func ValidateKeyExists(keys []string, params map[string]interface{}) map[string]
 	for _, key := range keys {
 		if _, ok := params[key]; !ok {
 			message := fmt.Sprintf("key '%s' not exists", key)
-			errs[key] = errors.New(message)
+			errs[key] = NewValidationError(ValidationCodeMissingKey, key, message)
 		}
 	}
 
@@ -40,7 +45,7 @@ func ValidateNotSpace(val interface{}) error {
 	}
 
 	if val.(string) == " " {
-		return fmt.Errorf(`can not be space only`)
+		return NewValidationError(ValidationCodeEmpty, "", "can not be space only")
 	}
 
 	return nil
@@ -60,7 +65,7 @@ func ValidateOnlyThaiLetterNumeric(val interface{}) error {
 	enExp := regexp.MustCompile(engRegex)
 
 	if !exp.MatchString(val.(string)) || enExp.MatchString(val.(string)) {
-		return errors.New("letter can be Thai letters and digits only")
+		return NewValidationError(ValidationCodeInvalidCharacters, "", "letter can be Thai letters and digits only")
 	}
 
 	return nil
@@ -79,7 +84,7 @@ func ValidateUUIDOrIDZero(val interface{}) error {
 	}
 
 	if _, err := uuid.Parse(val.(string)); err != nil {
-		return errors.New("value is not a valid UUID")
+		return NewValidationError(ValidationCodeNotUUID, "", "value is not a valid UUID")
 	}
 
 	return nil
@@ -94,18 +99,51 @@ func ValidateTypeUUID(val interface{}) error {
 	}
 
 	if _, err := uuid.Parse(val.(string)); err != nil {
-		return errors.New("value is not a valid UUID")
+		return NewValidationError(ValidationCodeNotUUID, "", "value is not a valid UUID")
 	}
 
 	return nil
 }
 
+// ValidateULID validates that the value is a valid ULID.
+// The value must be a string type in ULID format (e.g., "01ARZ3NDEKTSV4RRFFQ69G5FAV").
+// Returns an error if the value is not a string or not a valid ULID.
+func ValidateULID(val interface{}) error {
+	if err := ValidateTypeString(val); err != nil {
+		return err
+	}
+
+	if _, err := ulid.ParseStrict(val.(string)); err != nil {
+		return NewValidationError(ValidationCodeNotULID, "", "value is not a valid ULID")
+	}
+
+	return nil
+}
+
+// ValidateUUIDOrULID validates that the value is either a valid UUID or a valid ULID.
+// The value must be a string type.
+// Returns an error if the value is not a string or is neither a valid UUID nor ULID.
+func ValidateUUIDOrULID(val interface{}) error {
+	if err := ValidateTypeString(val); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(val.(string)); err == nil {
+		return nil
+	}
+	if _, err := ulid.ParseStrict(val.(string)); err == nil {
+		return nil
+	}
+
+	return NewValidationError(ValidationCodeNotUUIDOrULID, "", "value is not a valid UUID or ULID")
+}
+
 // ValidateTypeString validates that the value is of type string.
 // Returns an error if the value is not a string type.
 func ValidateTypeString(val interface{}) error {
 	rf := reflect.ValueOf(val)
 	if rf.Kind() != reflect.String {
-		return fmt.Errorf("value is not type string")
+		return NewValidationError(ValidationCodeNotString, "", "value is not type string")
 	}
 	return nil
 }
@@ -123,7 +161,7 @@ func ValidateTypeInt(val interface{}) error {
 		}
 	}
 	if rf.Kind() != reflect.Int {
-		return fmt.Errorf("value is not type int")
+		return NewValidationError(ValidationCodeNotInt, "", "value is not type int")
 	}
 	return nil
 }
@@ -133,7 +171,7 @@ func ValidateTypeInt(val interface{}) error {
 func ValidateTypeFloat(val interface{}) error {
 	rf := reflect.ValueOf(val)
 	if rf.Kind() != reflect.Float64 {
-		return errors.New("value is not type float")
+		return NewValidationError(ValidationCodeNotFloat, "", "value is not type float")
 	}
 	return nil
 }
@@ -143,7 +181,7 @@ func ValidateTypeFloat(val interface{}) error {
 func ValidateTypeMap(val interface{}) error {
 	rf := reflect.ValueOf(val)
 	if rf.Kind() != reflect.Map {
-		return errors.New("value is not type map")
+		return NewValidationError(ValidationCodeNotMap, "", "value is not type map")
 	}
 	return nil
 }
@@ -153,7 +191,7 @@ func ValidateTypeMap(val interface{}) error {
 func ValidateTypeSlice(val interface{}) error {
 	rf := reflect.ValueOf(val)
 	if rf.Kind() != reflect.Slice {
-		return errors.New("value is not type array")
+		return NewValidationError(ValidationCodeNotArray, "", "value is not type array")
 	}
 	return nil
 }
@@ -164,7 +202,7 @@ func ValidateTypeBool(v interface{}) error {
 	if reflect.TypeOf(v).Kind() == reflect.Bool {
 		return nil
 	}
-	return errors.New("value is not type bool")
+	return NewValidationError(ValidationCodeNotBool, "", "value is not type bool")
 }
 
 // ValidateTypeBoolString validates that the value is either a bool type or a string that can be parsed as bool.
@@ -179,7 +217,7 @@ func ValidateTypeBoolString(v interface{}) error {
 			return nil
 		}
 	}
-	return errors.New("value is not type bool")
+	return NewValidationError(ValidationCodeNotBool, "", "value is not type bool")
 }
 
 // ValidateTypeMapWithNull validates that the value is either nil or of type map.
@@ -190,7 +228,7 @@ func ValidateTypeMapWithNull(val interface{}) error {
 	}
 	rf := reflect.ValueOf(val)
 	if rf.Kind() != reflect.Map {
-		return errors.New("value must be null or type map")
+		return NewValidationError(ValidationCodeNotMapOrNull, "", "value must be null or type map")
 	}
 	return nil
 }
@@ -243,17 +281,55 @@ func ValidCitizenId(citizen string) bool {
 // In Thailand, company IDs start with 0, while personal IDs start with 1-9.
 // Returns true if the ID is valid and belongs to a company, false otherwise.
 func IsCompany(citizen string) bool {
-	if !ValidCitizenId(citizen) {
-		return false
+	info, err := ParseCitizenId(citizen)
+	return err == nil && info.IsCompany
+}
+
+// CitizenInfo is the result of ParseCitizenId.
+type CitizenInfo struct {
+	ID         string `json:"id"`
+	Valid      bool   `json:"valid"`       // Check digit matches
+	IsCompany  bool   `json:"is_company"`  // Juristic person ID (starts with 0)
+	CheckDigit int    `json:"check_digit"` // Expected last digit computed with MOD 11
+}
+
+// ParseCitizenId validates a Thai citizen ID and returns its details in one pass.
+// Returns a *ValidationError explaining the problem for wrong length (INVALID_LENGTH),
+// non-digit characters (NOT_NUMERIC) or a wrong check digit (INVALID_CHECKSUM); in the
+// last case the returned info still carries the expected CheckDigit.
+//
+// Example:
+//
+//	info, err := helper.ParseCitizenId("1101700230708")
+//	if err != nil {
+//	    helper.ValidationErrorResponse(c, err)
+//	    return
+//	}
+//	if info.IsCompany {
+//	    // Juristic person
+//	}
+func ParseCitizenId(citizen string) (CitizenInfo, error) {
+	info := CitizenInfo{ID: citizen}
+	if len(citizen) != 13 {
+		return info, NewValidationError(ValidationCodeInvalidLength, "", fmt.Sprintf("citizen id must be 13 digits, got %d", len(citizen)))
 	}
 
-	var num, err = strconv.Atoi(string([]rune(citizen)[0]))
-	if err != nil {
-		return false
+	total := 0
+	for i := 0; i < 13; i++ {
+		if citizen[i] < '0' || citizen[i] > '9' {
+			return info, NewValidationError(ValidationCodeNotNumeric, "", "citizen id must contain digits only")
+		}
+		if i < 12 {
+			total += int(citizen[i]-'0') * (13 - i)
+		}
 	}
-	if num == 0 {
-		return true
+
+	info.CheckDigit = (11 - total%11) % 10
+	info.IsCompany = citizen[0] == '0'
+	if int(citizen[12]-'0') != info.CheckDigit {
+		return info, NewValidationError(ValidationCodeInvalidChecksum, "", fmt.Sprintf("citizen id check digit must be %d", info.CheckDigit))
 	}
 
-	return false
+	info.Valid = true
+	return info, nil
 }
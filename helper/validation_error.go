@@ -0,0 +1,111 @@
+package helper
+
+import "errors"
+
+// Validation error codes returned by the Validate* functions
+const (
+	ValidationCodeMissingKey        = "MISSING_KEY"
+	ValidationCodeEmpty             = "EMPTY"
+	ValidationCodeInvalidCharacters = "INVALID_CHARACTERS"
+	ValidationCodeOutOfRange        = "OUT_OF_RANGE"
+	ValidationCodeInvalidLength     = "INVALID_LENGTH"
+	ValidationCodeNotNumeric        = "NOT_NUMERIC"
+	ValidationCodeNotAllowed        = "NOT_ALLOWED"
+	ValidationCodeInvalidChecksum   = "INVALID_CHECKSUM"
+	ValidationCodeNotUUID           = "NOT_A_UUID"
+	ValidationCodeNotULID           = "NOT_A_ULID"
+	ValidationCodeNotUUIDOrULID     = "NOT_A_UUID_OR_ULID"
+	ValidationCodeNotString         = "NOT_A_STRING"
+	ValidationCodeNotInt            = "NOT_AN_INT"
+	ValidationCodeNotFloat          = "NOT_A_FLOAT"
+	ValidationCodeNotBool           = "NOT_A_BOOL"
+	ValidationCodeNotMap            = "NOT_A_MAP"
+	ValidationCodeNotMapOrNull      = "NOT_A_MAP_OR_NULL"
+	ValidationCodeNotArray          = "NOT_AN_ARRAY"
+	ValidationCodeInvalidFormat     = "INVALID_FORMAT"
+)
+
+// ValidationError is a validation failure with a machine-readable code.
+// Message is the human-readable text returned by Error, so it can be translated by Code.
+type ValidationError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewValidationError creates a ValidationError.
+func NewValidationError(code, field, message string) *ValidationError {
+	return &ValidationError{Code: code, Field: field, Message: message}
+}
+
+// Error returns the human-readable message
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// WithField returns a copy of the error for the given field.
+//
+// Example:
+//
+//	if err := helper.ValidateTypeUUID(params["id"]); err != nil {
+//	    var ve *helper.ValidationError
+//	    if errors.As(err, &ve) {
+//	        return ve.WithField("id")
+//	    }
+//	}
+func (e *ValidationError) WithField(field string) *ValidationError {
+	copied := *e
+	copied.Field = field
+	return &copied
+}
+
+// ValidateField runs validators against val in order and returns the first error
+// with its Field set to field. Errors that are not a *ValidationError are returned as is.
+//
+// Example:
+//
+//	if err := helper.ValidateField("id", params["id"], helper.ValidateTypeUUID); err != nil {
+//	    return err // err.(*helper.ValidationError).Field == "id"
+//	}
+func ValidateField(field string, val interface{}, validators ...func(interface{}) error) error {
+	for _, validate := range validators {
+		err := validate(val)
+		if err == nil {
+			continue
+		}
+		var ve *ValidationError
+		if errors.As(err, &ve) {
+			return ve.WithField(field)
+		}
+		return err
+	}
+	return nil
+}
+
+// ValidationErrors extracts every ValidationError from err, including errors
+// combined with errors.Join or wrapped with fmt.Errorf("%w").
+//
+// Example:
+//
+//	for _, ve := range helper.ValidationErrors(err) {
+//	    log.Println(ve.Field, ve.Code)
+//	}
+func ValidationErrors(err error) []*ValidationError {
+	if err == nil {
+		return nil
+	}
+
+	switch wrapped := err.(type) {
+	case *ValidationError:
+		return []*ValidationError{wrapped}
+	case interface{ Unwrap() []error }:
+		var result []*ValidationError
+		for _, e := range wrapped.Unwrap() {
+			result = append(result, ValidationErrors(e)...)
+		}
+		return result
+	case interface{ Unwrap() error }:
+		return ValidationErrors(wrapped.Unwrap())
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// APIVersionHeader is the HTTP header for requesting an API version.
+	APIVersionHeader = "X-API-Version"
+
+	// ContextKeyAPIVersion is the Gin context key for the resolved API version.
+	ContextKeyAPIVersion = "api_version"
+)
+
+// pathVersionPattern matches a version path segment such as "v2"
+var pathVersionPattern = regexp.MustCompile(`^v\d+$`)
+
+// VersionConfig configures APIVersionMiddleware.
+type VersionConfig struct {
+	Supported []string // Supported versions, e.g. []string{"v1", "v2"}
+	Default   string   // Version used when the request does not specify one (usually the latest)
+	Vendor    string   // Vendor name in Accept media types, e.g. "myapi" for application/vnd.myapi.v2+json
+	FromPath  bool     // Also read the version from a path segment such as /api/v2/users
+}
+
+// APIVersionMiddleware resolves the requested API version and stores it in context.
+//
+// The version is taken from, in order: a path segment (when FromPath is enabled),
+// the X-API-Version header, and the Accept header (application/vnd.{vendor}.v2+json).
+// Versions may be written as "2" or "v2". Unsupported versions are rejected with
+// 400 UNSUPPORTED_API_VERSION; when none is specified, Default is used.
+//
+// Example:
+//
+//	r.Use(middleware.APIVersionMiddleware(middleware.VersionConfig{
+//	    Supported: []string{"v1", "v2"},
+//	    Default:   "v2",
+//	    Vendor:    "myapi",
+//	}))
+//
+//	// In handler
+//	if middleware.GetAPIVersion(c) == "v1" {
+//	    c.JSON(200, legacyResponse(user))
+//	    return
+//	}
+func APIVersionMiddleware(cfg VersionConfig) gin.HandlerFunc {
+	supported := make(map[string]bool, len(cfg.Supported))
+	for _, v := range cfg.Supported {
+		supported[normalizeAPIVersion(v)] = true
+	}
+	defaultVersion := normalizeAPIVersion(cfg.Default)
+
+	return func(c *gin.Context) {
+		version := ""
+		if cfg.FromPath {
+			version = apiVersionFromPath(c.Request.URL.Path)
+		}
+		if version == "" {
+			version = normalizeAPIVersion(c.GetHeader(APIVersionHeader))
+		}
+		if version == "" && cfg.Vendor != "" {
+			version = apiVersionFromAccept(c.GetHeader("Accept"), cfg.Vendor)
+		}
+		if version == "" {
+			version = defaultVersion
+		}
+
+		if !supported[version] {
+			helper.ErrorResponse(c, http.StatusBadRequest, "UNSUPPORTED_API_VERSION", "Supported API versions: "+strings.Join(cfg.Supported, ", "))
+			c.Abort()
+			return
+		}
+
+		c.Set(ContextKeyAPIVersion, version)
+		c.Next()
+	}
+}
+
+// GetAPIVersion retrieves the API version resolved by APIVersionMiddleware.
+//
+// Returns empty string if the middleware was not applied.
+func GetAPIVersion(c *gin.Context) string {
+	version, _ := helper.GetValue[string](c, ContextKeyAPIVersion)
+	return version
+}
+
+// normalizeAPIVersion converts "2", "V2" and "v2" to "v2"
+func normalizeAPIVersion(version string) string {
+	version = strings.ToLower(strings.TrimSpace(version))
+	if version == "" {
+		return ""
+	}
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return version
+}
+
+// apiVersionFromPath returns the first path segment that looks like a version
+func apiVersionFromPath(path string) string {
+	for _, segment := range strings.Split(path, "/") {
+		if pathVersionPattern.MatchString(segment) {
+			return segment
+		}
+	}
+	return ""
+}
+
+// apiVersionFromAccept extracts the version from application/vnd.{vendor}.{version}+json
+func apiVersionFromAccept(accept string, vendor string) string {
+	prefix := "application/vnd." + strings.ToLower(vendor) + "."
+	for _, mediaType := range strings.Split(accept, ",") {
+		mediaType, _, _ = strings.Cut(strings.ToLower(strings.TrimSpace(mediaType)), ";")
+		rest, ok := strings.CutPrefix(strings.TrimSpace(mediaType), prefix)
+		if !ok {
+			continue
+		}
+		version, _, _ := strings.Cut(rest, "+")
+		return normalizeAPIVersion(version)
+	}
+	return ""
+}
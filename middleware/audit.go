@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultAuditRedactKeys are body keys masked when no RedactKeys are configured.
+var DefaultAuditRedactKeys = []string{"password", "token", "secret", "api_key", "access_token", "refresh_token"}
+
+// AuditEntry is a single audit trail record for a mutating request.
+type AuditEntry struct {
+	Timestamp time.Time   `json:"timestamp"`
+	RequestID string      `json:"request_id"`
+	UserID    string      `json:"user_id,omitempty"`
+	Method    string      `json:"method"`
+	Path      string      `json:"path"`
+	Route     string      `json:"route"`
+	ClientIP  string      `json:"client_ip"`
+	Status    int         `json:"status"`
+	Body      interface{} `json:"body,omitempty"` // Redacted JSON body, only when CaptureBody is enabled
+}
+
+// AuditSink receives audit entries. Implement it to persist entries to a database.
+type AuditSink interface {
+	WriteAudit(entry AuditEntry)
+}
+
+// AuditSinkFunc adapts a function to the AuditSink interface.
+type AuditSinkFunc func(entry AuditEntry)
+
+// WriteAudit calls f(entry).
+func (f AuditSinkFunc) WriteAudit(entry AuditEntry) {
+	f(entry)
+}
+
+// AuditConfig configures AuditMiddlewareWithConfig.
+type AuditConfig struct {
+	Sink         AuditSink // Destination for audit entries (required)
+	CaptureBody  bool      // Capture the JSON request body in the entry
+	MaxBodyBytes int64     // Maximum body size to capture (default 64KB)
+	RedactKeys   []string  // Body keys to mask, case-insensitive (default DefaultAuditRedactKeys)
+}
+
+// LogrusAuditSink returns an AuditSink that writes entries through logrus.
+//
+// Example:
+//
+//	r.Use(middleware.AuditMiddleware(middleware.LogrusAuditSink(logger)))
+func LogrusAuditSink(logger *logrus.Logger) AuditSinkFunc {
+	return func(entry AuditEntry) {
+		fields := logrus.Fields{
+			"audit":      true,
+			"timestamp":  entry.Timestamp.Format(time.RFC3339),
+			"request_id": entry.RequestID,
+			"user_id":    entry.UserID,
+			"method":     entry.Method,
+			"path":       entry.Path,
+			"route":      entry.Route,
+			"client_ip":  entry.ClientIP,
+			"status":     entry.Status,
+		}
+		if entry.Body != nil {
+			fields["body"] = entry.Body
+		}
+		logger.WithFields(fields).Info("Audit")
+	}
+}
+
+// AuditMiddleware records an audit trail for mutating requests (POST, PUT, PATCH, DELETE).
+//
+// Each entry contains method, path, user_id, request_id, client IP, status and timestamp.
+// The sink is called asynchronously after the handler completes, so it cannot slow down responses.
+// Unlike LoggerMiddleware this is meant for compliance, not operations.
+//
+// Example:
+//
+//	r.Use(middleware.AuditMiddleware(func(entry middleware.AuditEntry) {
+//	    db.Create(&AuditLog{UserID: entry.UserID, Path: entry.Path, Status: entry.Status})
+//	}))
+func AuditMiddleware(sink func(AuditEntry)) gin.HandlerFunc {
+	return AuditMiddlewareWithConfig(AuditConfig{Sink: AuditSinkFunc(sink)})
+}
+
+// AuditMiddlewareWithConfig records an audit trail with optional redacted body capture.
+//
+// Example:
+//
+//	r.Use(middleware.AuditMiddlewareWithConfig(middleware.AuditConfig{
+//	    Sink:        middleware.LogrusAuditSink(logger),
+//	    CaptureBody: true,
+//	    RedactKeys:  []string{"password", "citizen_id"},
+//	}))
+func AuditMiddlewareWithConfig(cfg AuditConfig) gin.HandlerFunc {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 64 << 10
+	}
+	if cfg.RedactKeys == nil {
+		cfg.RedactKeys = DefaultAuditRedactKeys
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		startTime := time.Now()
+
+		var body interface{}
+		if cfg.CaptureBody && strings.Contains(c.ContentType(), "application/json") {
+			if raw, err := helper.ReadAndRestoreBody(c, cfg.MaxBodyBytes); err == nil && len(raw) > 0 {
+				var decoded interface{}
+				if json.Unmarshal(raw, &decoded) == nil {
+					body = redactAuditValue(decoded, cfg.RedactKeys)
+				}
+			}
+		}
+
+		c.Next()
+
+		entry := AuditEntry{
+			Timestamp: startTime,
+			RequestID: GetRequestID(c),
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+			Route:     c.FullPath(),
+			ClientIP:  c.ClientIP(),
+			Status:    c.Writer.Status(),
+			Body:      body,
+		}
+		if userID, exists := c.Get(helper.ContextKeyUserID); exists {
+			entry.UserID = fmt.Sprint(userID)
+		}
+
+		if cfg.Sink != nil {
+			helper.Go(func() { cfg.Sink.WriteAudit(entry) })
+		}
+	}
+}
+
+// redactAuditValue returns a copy of a decoded JSON value with sensitive keys masked
+func redactAuditValue(v interface{}, keys []string) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if isAuditRedactKey(k, keys) {
+				out[k] = "***"
+				continue
+			}
+			out[k] = redactAuditValue(item, keys)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = redactAuditValue(item, keys)
+		}
+		return out
+	}
+	return v
+}
+
+// isAuditRedactKey checks if key matches any redact key, ignoring case
+func isAuditRedactKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if strings.EqualFold(key, k) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,14 +2,38 @@ package middleware
 
 import (
 	"net/http"
-	"strings"
 
+	"github.com/AECInfraconnect/go-module-helper/convert"
 	"github.com/AECInfraconnect/go-module-helper/helper"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+// ContextKeyClaims is the Gin context key for the typed *Claims set by JWTAuthMiddlewareTyped.
+const ContextKeyClaims = "claims"
+
+// Claims is the typed JWT payload used by JWTAuthMiddlewareTyped.
+type Claims struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role,omitempty"`
+	Email  string    `json:"email,omitempty"`
+
+	Permissions []string `json:"permissions,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// jwtKeyFunc returns a jwt.Keyfunc that only accepts HMAC signed tokens
+func jwtKeyFunc(jwtSecret string) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(jwtSecret), nil
+	}
+}
+
 // APIKeyAuthMiddleware validates API keys for service-to-service authentication.
 //
 // Reads the API-Key header and compares it against the provided apiKey.
@@ -51,6 +75,7 @@ func APIKeyAuthMiddleware(apiKey string) gin.HandlerFunc {
 //
 // Attempts API key authentication first, then falls back to JWT.
 // Useful for endpoints that need to support both service and user authentication.
+// JWTs without a valid UUID user_id claim are rejected with 401 MISSING_USER_CLAIM.
 //
 // Example:
 //
@@ -74,21 +99,15 @@ func APIKeyOrJWTAuthMiddleware(apiKey string, jwtSecret string) gin.HandlerFunc
 		}
 
 		// Extract token from "Bearer <token>"
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
+		tokenString, ok := helper.ExtractBearerToken(c)
+		if !ok {
 			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Token must be in Bearer format")
 			c.Abort()
 			return
 		}
 
 		// Parse token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwt.Parse(tokenString, jwtKeyFunc(jwtSecret))
 
 		if err != nil || !token.Valid {
 			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN", "Token is invalid or expired")
@@ -96,15 +115,15 @@ func APIKeyOrJWTAuthMiddleware(apiKey string, jwtSecret string) gin.HandlerFunc
 			return
 		}
 
-		// Extract claims
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			// Extract user ID
-			if userIDStr, ok := claims["user_id"].(string); ok {
-				if userID, err := uuid.Parse(userIDStr); err == nil {
-					c.Set(helper.ContextKeyUserID, userID)
-				}
-			}
+		// Extract user ID
+		userID, ok := userIDFromMapClaims(token.Claims)
+		if !ok {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_USER_CLAIM", "Token is missing a valid user_id claim")
+			c.Abort()
+			return
 		}
+		c.Set(helper.ContextKeyUserID, userID)
+		c.Set(helper.ContextKeyToken, tokenString)
 
 		c.Next()
 	}
@@ -114,6 +133,9 @@ func APIKeyOrJWTAuthMiddleware(apiKey string, jwtSecret string) gin.HandlerFunc
 //
 // Expects "Authorization: Bearer <token>" header format.
 // Extracts user_id from JWT claims and stores it in context.
+// Tokens without a valid UUID user_id claim are rejected with 401 MISSING_USER_CLAIM.
+// The raw token is stored too, so handlers can relay it with helper.GetTokenFromContext
+// (the other JWT middlewares do the same).
 //
 // Example:
 //
@@ -129,21 +151,15 @@ func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 		// Extract token from "Bearer <token>"
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
+		tokenString, ok := helper.ExtractBearerToken(c)
+		if !ok {
 			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Token must be in Bearer format")
 			c.Abort()
 			return
 		}
 
 		// Parse token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwt.Parse(tokenString, jwtKeyFunc(jwtSecret))
 
 		if err != nil || !token.Valid {
 			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN", "Token is invalid or expired")
@@ -151,14 +167,184 @@ func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		// Extract claims
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			// Extract user ID
-			if userIDStr, ok := claims["user_id"].(string); ok {
-				if userID, err := uuid.Parse(userIDStr); err == nil {
-					c.Set(helper.ContextKeyUserID, userID)
-				}
-			}
+		// Extract user ID, a token without a valid user is not an authentication
+		userID, ok := userIDFromMapClaims(token.Claims)
+		if !ok {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_USER_CLAIM", "Token is missing a valid user_id claim")
+			c.Abort()
+			return
+		}
+		c.Set(helper.ContextKeyUserID, userID)
+		c.Set(helper.ContextKeyToken, tokenString)
+
+		c.Next()
+	}
+}
+
+// userIDFromMapClaims extracts a UUID user_id claim
+func userIDFromMapClaims(claims jwt.Claims) (uuid.UUID, bool) {
+	mapClaims, ok := claims.(jwt.MapClaims)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userIDStr, ok := mapClaims["user_id"].(string)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil || userID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// JWTAuthMiddlewareTyped validates JWT tokens and binds the payload into a typed Claims struct.
+//
+// Expects "Authorization: Bearer <token>" header format.
+// Stores *Claims in context under ContextKeyClaims (read it with GetClaims) and
+// also sets helper.ContextKeyUserID for compatibility with the other helpers.
+// Tokens without a valid user_id claim are rejected with 401 MISSING_USER_CLAIM.
+//
+// Example:
+//
+//	auth := r.Group("/auth")
+//	auth.Use(middleware.JWTAuthMiddlewareTyped("jwt-secret"))
+//	auth.GET("/me", func(c *gin.Context) {
+//	    claims, _ := middleware.GetClaims(c)
+//	    c.JSON(200, gin.H{"user_id": claims.UserID, "role": claims.Role})
+//	})
+func JWTAuthMiddlewareTyped(jwtSecret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_TOKEN", "Authorization header is required")
+			c.Abort()
+			return
+		}
+
+		// Extract token from "Bearer <token>"
+		tokenString, ok := helper.ExtractBearerToken(c)
+		if !ok {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Token must be in Bearer format")
+			c.Abort()
+			return
+		}
+
+		// Parse token into typed claims
+		claims := &Claims{}
+		token, err := jwt.ParseWithClaims(tokenString, claims, jwtKeyFunc(jwtSecret))
+		if err != nil || !token.Valid {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN", "Token is invalid or expired")
+			c.Abort()
+			return
+		}
+
+		if claims.UserID == uuid.Nil {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_USER_CLAIM", "Token is missing a valid user_id claim")
+			c.Abort()
+			return
+		}
+
+		c.Set(ContextKeyClaims, claims)
+		c.Set(helper.ContextKeyUserID, claims.UserID)
+		c.Set(helper.ContextKeyToken, tokenString)
+		if claims.Role != "" {
+			c.Set(helper.ContextKeyUserRole, claims.Role)
+		}
+		c.Next()
+	}
+}
+
+// GetClaims retrieves the typed claims set by JWTAuthMiddlewareTyped.
+//
+// Returns false if the middleware was not applied.
+func GetClaims(c *gin.Context) (*Claims, bool) {
+	value, exists := c.Get(ContextKeyClaims)
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
+
+// JWTConfig configures JWTAuthMiddlewareWithConfig.
+type JWTConfig struct {
+	SecretKey   string // HMAC secret used to verify tokens
+	UserIDClaim string // Claim holding the user ID (default "user_id")
+	RoleClaim   string // Claim holding the user role (default "role")
+	EmailClaim  string // Claim holding the user email (default "email")
+}
+
+// JWTAuthMiddlewareWithConfig validates JWT tokens with configurable claim names.
+//
+// Use it for issuers that put the user ID under another claim, e.g. "sub".
+// The user ID is stored as uuid.UUID when it parses as a UUID and as a string otherwise;
+// read it with helper.GetUserIDStringFromContext when IDs are not UUIDs.
+// Role and email are stored under helper.ContextKeyUserRole and helper.ContextKeyUserEmail.
+// Tokens without the user ID claim are rejected with 401 MISSING_USER_CLAIM.
+//
+// Example:
+//
+//	r.Use(middleware.JWTAuthMiddlewareWithConfig(middleware.JWTConfig{
+//	    SecretKey:   "jwt-secret",
+//	    UserIDClaim: "sub",
+//	}))
+func JWTAuthMiddlewareWithConfig(cfg JWTConfig) gin.HandlerFunc {
+	if cfg.UserIDClaim == "" {
+		cfg.UserIDClaim = "user_id"
+	}
+	if cfg.RoleClaim == "" {
+		cfg.RoleClaim = "role"
+	}
+	if cfg.EmailClaim == "" {
+		cfg.EmailClaim = "email"
+	}
+
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_TOKEN", "Authorization header is required")
+			c.Abort()
+			return
+		}
+
+		// Extract token from "Bearer <token>"
+		tokenString, ok := helper.ExtractBearerToken(c)
+		if !ok {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN_FORMAT", "Token must be in Bearer format")
+			c.Abort()
+			return
+		}
+
+		// Parse token
+		token, err := jwt.Parse(tokenString, jwtKeyFunc(cfg.SecretKey))
+		if err != nil || !token.Valid {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN", "Token is invalid or expired")
+			c.Abort()
+			return
+		}
+
+		claims, _ := token.Claims.(jwt.MapClaims)
+
+		// Extract user ID, as UUID when possible
+		rawUserID := convert.ToString(claims[cfg.UserIDClaim])
+		if rawUserID == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_USER_CLAIM", "Token is missing the "+cfg.UserIDClaim+" claim")
+			c.Abort()
+			return
+		}
+		if userID, err := uuid.Parse(rawUserID); err == nil {
+			c.Set(helper.ContextKeyUserID, userID)
+		} else {
+			c.Set(helper.ContextKeyUserID, rawUserID)
+		}
+		c.Set(helper.ContextKeyToken, tokenString)
+
+		if role, ok := claims[cfg.RoleClaim].(string); ok && role != "" {
+			c.Set(helper.ContextKeyUserRole, role)
+		}
+		if email, ok := claims[cfg.EmailClaim].(string); ok && email != "" {
+			c.Set(helper.ContextKeyUserEmail, email)
 		}
 
 		c.Next()
@@ -181,24 +367,20 @@ func OptionalJWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
+		tokenString, ok := helper.ExtractBearerToken(c)
+		if !ok {
 			c.Next()
 			return
 		}
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwt.Parse(tokenString, jwtKeyFunc(jwtSecret))
 
 		if err == nil && token.Valid {
 			if claims, ok := token.Claims.(jwt.MapClaims); ok {
 				if userIDStr, ok := claims["user_id"].(string); ok {
-					if userID, err := uuid.Parse(userIDStr); err == nil {
+					if userID := helper.ParseUUIDOrNil(userIDStr); userID != uuid.Nil {
 						c.Set(helper.ContextKeyUserID, userID)
+						c.Set(helper.ContextKeyToken, tokenString)
 					}
 				}
 			}
@@ -208,26 +390,92 @@ func OptionalJWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 	}
 }
 
+// PermissionChecker decides whether the authenticated user has a permission scope.
+// Pass one to RequirePermission to query your database or permission service.
+type PermissionChecker func(c *gin.Context, scope string) (bool, error)
+
+// claimsPermissionChecker checks the permissions claim stored in context by JWTAuthMiddlewareTyped
+func claimsPermissionChecker(c *gin.Context, scope string) (bool, error) {
+	claims, ok := GetClaims(c)
+	return ok && helper.Contains(claims.Permissions, scope), nil
+}
+
 // RequirePermission checks if the authenticated user has a specific permission.
 //
-// This is a placeholder for custom permission logic.
-// Implement by querying your database or permission service.
-func RequirePermission(scope string) gin.HandlerFunc {
+// The first checker decides; without one, the permissions claim stored in context by
+// JWTAuthMiddlewareTyped is checked.
+// Returns 401 UNAUTHORIZED when the request is not authenticated,
+// 403 FORBIDDEN when the user lacks the permission and 500 when the checker fails.
+//
+// Example:
+//
+//	hasScope := func(c *gin.Context, scope string) (bool, error) {
+//	    userID, _ := helper.GetUserIDFromContext(c)
+//	    return permissionRepo.HasScope(c, userID, scope)
+//	}
+//	r.DELETE("/users/:id", middleware.RequirePermission("users:delete", hasScope), deleteUser)
+func RequirePermission(scope string, checker ...PermissionChecker) gin.HandlerFunc {
+	check := PermissionChecker(claimsPermissionChecker)
+	if len(checker) > 0 && checker[0] != nil {
+		check = checker[0]
+	}
+
 	return func(c *gin.Context) {
-		// TODO: Implement permission check logic
-		// This would typically query the database to check user permissions
+		if _, exists := c.Get(helper.ContextKeyUserID); !exists {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication is required")
+			c.Abort()
+			return
+		}
+
+		allowed, err := check(c, scope)
+		if err != nil {
+			helper.ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "Unable to check permission")
+			c.Abort()
+			return
+		}
+
+		if !allowed {
+			helper.ErrorResponse(c, http.StatusForbidden, "FORBIDDEN", "You do not have permission to access this resource")
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
 
 // RequireRole checks if the authenticated user has a specific role.
 //
-// This is a placeholder for custom role logic.
-// Implement by querying your database or role service.
+// The role is read from the typed claims (JWTAuthMiddlewareTyped), or from
+// helper.ContextKeyUserRole when set by a custom authentication middleware.
+// Returns 401 UNAUTHORIZED when the request is not authenticated and
+// 403 FORBIDDEN when the role does not match.
+//
+// Example:
+//
+//	admin := r.Group("/admin")
+//	admin.Use(middleware.JWTAuthMiddlewareTyped("jwt-secret"), middleware.RequireRole("admin"))
 func RequireRole(roleName string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// TODO: Implement role check logic
-		// This would typically query the database to check user roles
+		if _, exists := c.Get(helper.ContextKeyUserID); !exists {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication is required")
+			c.Abort()
+			return
+		}
+
+		var role string
+		if claims, ok := GetClaims(c); ok {
+			role = claims.Role
+		} else {
+			role = c.GetString(helper.ContextKeyUserRole)
+		}
+
+		if role != roleName {
+			helper.ErrorResponse(c, http.StatusForbidden, "FORBIDDEN", "You do not have permission to access this resource")
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
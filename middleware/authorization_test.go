@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const testJWTSecret = "test-secret"
+
+// signTestToken signs claims with testJWTSecret
+func signTestToken(t *testing.T, claims jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// serveAuth runs a GET / through handlers and returns the status and error code
+func serveAuth(t *testing.T, authHeader string, handlers ...gin.HandlerFunc) (int, string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	handlers = append(handlers, func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/", handlers...)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &body)
+	return w.Code, body.Error.Code
+}
+
+func TestJWTAuthMiddlewareStatusCodes(t *testing.T) {
+	userID := uuid.NewString()
+	expired := signTestToken(t, jwt.MapClaims{"user_id": userID, "exp": time.Now().Add(-time.Hour).Unix()})
+	otherSecret, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user_id": userID}).SignedString([]byte("other-secret"))
+
+	tests := []struct {
+		name       string
+		authHeader string
+		wantStatus int
+		wantCode   string
+	}{
+		{"valid", "Bearer " + signTestToken(t, jwt.MapClaims{"user_id": userID}), http.StatusOK, ""},
+		{"missing header", "", http.StatusUnauthorized, "MISSING_TOKEN"},
+		{"not bearer", "Basic abc", http.StatusUnauthorized, "INVALID_TOKEN_FORMAT"},
+		{"expired", "Bearer " + expired, http.StatusUnauthorized, "INVALID_TOKEN"},
+		{"wrong secret", "Bearer " + otherSecret, http.StatusUnauthorized, "INVALID_TOKEN"},
+		{"missing user_id", "Bearer " + signTestToken(t, jwt.MapClaims{"role": "admin"}), http.StatusUnauthorized, "MISSING_USER_CLAIM"},
+		{"invalid user_id", "Bearer " + signTestToken(t, jwt.MapClaims{"user_id": "42"}), http.StatusUnauthorized, "MISSING_USER_CLAIM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serveAuth(t, tt.authHeader, JWTAuthMiddleware(testJWTSecret))
+			if status != tt.wantStatus || code != tt.wantCode {
+				t.Errorf("got %d %q, want %d %q", status, code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	token := func(role string) string {
+		return "Bearer " + signTestToken(t, &Claims{UserID: uuid.New(), Role: role})
+	}
+
+	tests := []struct {
+		name       string
+		handlers   []gin.HandlerFunc
+		authHeader string
+		wantStatus int
+		wantCode   string
+	}{
+		{"matching role", []gin.HandlerFunc{JWTAuthMiddlewareTyped(testJWTSecret), RequireRole("admin")}, token("admin"), http.StatusOK, ""},
+		{"other role", []gin.HandlerFunc{JWTAuthMiddlewareTyped(testJWTSecret), RequireRole("admin")}, token("viewer"), http.StatusForbidden, "FORBIDDEN"},
+		{"no role", []gin.HandlerFunc{JWTAuthMiddlewareTyped(testJWTSecret), RequireRole("admin")}, token(""), http.StatusForbidden, "FORBIDDEN"},
+		{"not authenticated", []gin.HandlerFunc{RequireRole("admin")}, "", http.StatusUnauthorized, "UNAUTHORIZED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serveAuth(t, tt.authHeader, tt.handlers...)
+			if status != tt.wantStatus || code != tt.wantCode {
+				t.Errorf("got %d %q, want %d %q", status, code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	token := "Bearer " + signTestToken(t, &Claims{UserID: uuid.New(), Permissions: []string{"users:read"}})
+	auth := JWTAuthMiddlewareTyped(testJWTSecret)
+	grant := func(*gin.Context, string) (bool, error) { return true, nil }
+	deny := func(*gin.Context, string) (bool, error) { return false, nil }
+	fail := func(*gin.Context, string) (bool, error) { return false, errors.New("db down") }
+
+	tests := []struct {
+		name       string
+		handlers   []gin.HandlerFunc
+		authHeader string
+		wantStatus int
+		wantCode   string
+	}{
+		{"claim granted", []gin.HandlerFunc{auth, RequirePermission("users:read")}, token, http.StatusOK, ""},
+		{"claim missing", []gin.HandlerFunc{auth, RequirePermission("users:delete")}, token, http.StatusForbidden, "FORBIDDEN"},
+		{"not authenticated", []gin.HandlerFunc{RequirePermission("users:read")}, "", http.StatusUnauthorized, "UNAUTHORIZED"},
+		{"checker denies", []gin.HandlerFunc{auth, RequirePermission("users:read", deny)}, token, http.StatusForbidden, "FORBIDDEN"},
+		{"checker grants", []gin.HandlerFunc{auth, RequirePermission("users:delete", grant)}, token, http.StatusOK, ""},
+		{"checker fails", []gin.HandlerFunc{auth, RequirePermission("users:read", fail)}, token, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serveAuth(t, tt.authHeader, tt.handlers...)
+			if status != tt.wantStatus || code != tt.wantCode {
+				t.Errorf("got %d %q, want %d %q", status, code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestUserlessTokensRejected(t *testing.T) {
+	userless := "Bearer " + signTestToken(t, jwt.MapClaims{"role": "admin", "exp": time.Now().Add(time.Hour).Unix()})
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+	}{
+		{"JWTAuthMiddleware", JWTAuthMiddleware(testJWTSecret)},
+		{"JWTAuthMiddlewareTyped", JWTAuthMiddlewareTyped(testJWTSecret)},
+		{"JWTAuthMiddlewareWithConfig", JWTAuthMiddlewareWithConfig(JWTConfig{SecretKey: testJWTSecret})},
+		{"APIKeyOrJWTAuthMiddleware", APIKeyOrJWTAuthMiddleware("api-key", testJWTSecret)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serveAuth(t, userless, tt.handler)
+			if status != http.StatusUnauthorized || code != "MISSING_USER_CLAIM" {
+				t.Errorf("got %d %q, want 401 MISSING_USER_CLAIM", status, code)
+			}
+		})
+	}
+}
+
+func TestOptionalJWTAuthMiddlewareAllowsUserlessTokens(t *testing.T) {
+	userless := "Bearer " + signTestToken(t, jwt.MapClaims{"role": "admin"})
+
+	var authenticated bool
+	status, _ := serveAuth(t, userless, OptionalJWTAuthMiddleware(testJWTSecret), func(c *gin.Context) {
+		_, authenticated = c.Get(helper.ContextKeyUserID)
+	})
+	if status != http.StatusOK {
+		t.Fatalf("status = %d, want 200", status)
+	}
+	if authenticated {
+		t.Error("a token without user_id must not set a user")
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+func TestBodyReadingMiddlewaresRejectOversizedBodies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	schema := `{"type": "object", "properties": {"name": {"type": "string"}}}`
+
+	tests := []struct {
+		name       string
+		middleware gin.HandlerFunc
+	}{
+		{"hmac", HMACSignatureMiddlewareWithConfig(HMACConfig{Secret: "secret", MaxBodyBytes: 16})},
+		{"json schema", JSONSchemaMiddlewareWithConfig(JSONSchemaConfig{Schema: schema, MaxBodyBytes: 16})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST("/", tt.middleware, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			send := func(body string) int {
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("X-Signature", helper.SignHMACSHA256("secret", []byte(body)))
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				return w.Code
+			}
+
+			if code := send(`{"name":"a"}`); code != http.StatusOK {
+				t.Errorf("small body: got %d, want 200", code)
+			}
+			if code := send(`{"name":"` + strings.Repeat("a", 32) + `"}`); code != http.StatusRequestEntityTooLarge {
+				t.Errorf("oversized body: got %d, want 413", code)
+			}
+		})
+	}
+}
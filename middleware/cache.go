@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheHeader reports whether a response was served from CacheMiddleware ("HIT" or "MISS").
+const CacheHeader = "X-Cache"
+
+// CachedResponse is a response stored by CacheMiddleware.
+type CachedResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// CacheStore stores responses for CacheMiddleware.
+//
+// Implement it on top of Redis to share the cache across instances, e.g. by storing the
+// response as JSON with SET key value PX ttl.
+type CacheStore interface {
+	// Get returns the response stored under key, or false if there is none or it expired.
+	Get(ctx context.Context, key string) (*CachedResponse, bool, error)
+	// Set stores response under key for ttl.
+	Set(ctx context.Context, key string, response *CachedResponse, ttl time.Duration) error
+}
+
+// MemoryCacheStore is an in-memory CacheStore for single-instance deployments.
+//
+// Expired responses are cleaned up every minute.
+type MemoryCacheStore struct {
+	entries map[string]memoryCacheEntry
+	mu      sync.RWMutex
+}
+
+// memoryCacheEntry is a response with its expiry time
+type memoryCacheEntry struct {
+	response  *CachedResponse
+	expiresAt time.Time
+}
+
+// NewMemoryCacheStore creates a new in-memory cache store with automatic cleanup.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	store := &MemoryCacheStore{
+		entries: make(map[string]memoryCacheEntry),
+	}
+	// Start cleanup goroutine to remove expired responses
+	go store.cleanup()
+	return store
+}
+
+// cleanup removes expired responses every minute
+func (s *MemoryCacheStore) cleanup() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		now := time.Now()
+		for key, entry := range s.entries {
+			if now.After(entry.expiresAt) {
+				delete(s.entries, key)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Get returns the response stored under key, or false if there is none or it expired.
+func (s *MemoryCacheStore) Get(ctx context.Context, key string) (*CachedResponse, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, exists := s.entries[key]
+	if !exists || time.Now().After(entry.expiresAt) {
+		return nil, false, nil
+	}
+	return entry.response, true, nil
+}
+
+// Set stores response under key for ttl.
+func (s *MemoryCacheStore) Set(ctx context.Context, key string, response *CachedResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryCacheEntry{response: response, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// Delete removes the response stored under key, e.g. after the underlying data changed.
+func (s *MemoryCacheStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+}
+
+// CacheMiddleware caches successful GET responses (status, headers and body) for ttl.
+//
+// Responses are keyed by keyFunc (default: method, path and query). A hit is served from
+// the store without running the handler; every cached route answers with X-Cache: HIT or
+// MISS. Only GET requests with a 2xx status are cached, and store errors fall back to
+// running the handler. Responses with Cache-Control private or no-store are not cached;
+// Set-Cookie and X-Request-ID are never stored, and headers already set by earlier
+// middlewares are not overwritten by cached ones. store defaults to NewMemoryCacheStore().
+//
+// Invalidation is the caller's responsibility: the cache does not know when data changes.
+// Keep ttl short, or delete keys (MemoryCacheStore.Delete) after writes. Do not cache
+// per-user responses unless keyFunc includes the user.
+//
+// Example:
+//
+//	store := middleware.NewMemoryCacheStore()
+//	r.GET("/products", middleware.CacheMiddleware(30*time.Second, nil, store), listProducts)
+//
+//	// Per-user cache key
+//	r.GET("/me/summary", middleware.CacheMiddleware(time.Minute, func(c *gin.Context) string {
+//	    userID, _ := helper.GetUserIDStringFromContext(c)
+//	    return userID + ":" + c.Request.URL.RequestURI()
+//	}, store), getSummary)
+func CacheMiddleware(ttl time.Duration, keyFunc func(*gin.Context) string, store CacheStore) gin.HandlerFunc {
+	if keyFunc == nil {
+		keyFunc = func(c *gin.Context) string {
+			return c.Request.Method + " " + c.Request.URL.RequestURI()
+		}
+	}
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		key := keyFunc(c)
+		if cached, hit, err := store.Get(ctx, key); err == nil && hit {
+			header := c.Writer.Header()
+			for name, values := range cached.Header {
+				if _, exists := header[name]; !exists {
+					header[name] = append([]string(nil), values...)
+				}
+			}
+			header.Set(CacheHeader, "HIT")
+			c.Status(cached.Status)
+			c.Writer.Write(cached.Body)
+			c.Abort()
+			return
+		}
+
+		c.Header(CacheHeader, "MISS")
+		recorder := &cacheRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = recorder.ResponseWriter
+
+		status := recorder.Status()
+		if status < 200 || status >= 300 || !sharedCacheable(recorder.Header()) {
+			return
+		}
+		header := recorder.Header().Clone()
+		header.Del(CacheHeader)
+		header.Del(RequestIDHeader)
+		header.Del("Set-Cookie")
+		store.Set(ctx, key, &CachedResponse{Status: status, Header: header, Body: recorder.body.Bytes()}, ttl)
+	}
+}
+
+// sharedCacheable reports whether a response may be served to other clients
+func sharedCacheable(header http.Header) bool {
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, _, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if strings.EqualFold(name, "private") || strings.EqualFold(name, "no-store") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// cacheRecorder copies the response body while it is written
+type cacheRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes data to the response and the copy
+func (w *cacheRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes s to the response and the copy
+func (w *cacheRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCacheMiddlewareDoesNotShareCookies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/items", CacheMiddleware(time.Minute, nil, NewMemoryCacheStore()), func(c *gin.Context) {
+		c.SetCookie("session", "alice", 3600, "/", "", false, true)
+		c.String(http.StatusOK, "items")
+	})
+
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items", nil))
+		return w
+	}
+
+	if w := serve(); w.Header().Get(CacheHeader) != "MISS" {
+		t.Fatalf("first request: X-Cache = %q, want MISS", w.Header().Get(CacheHeader))
+	}
+	w := serve()
+	if w.Header().Get(CacheHeader) != "HIT" {
+		t.Fatalf("second request: X-Cache = %q, want HIT", w.Header().Get(CacheHeader))
+	}
+	if cookie := w.Header().Get("Set-Cookie"); cookie != "" {
+		t.Fatalf("cached response replayed Set-Cookie %q", cookie)
+	}
+	if w.Body.String() != "items" {
+		t.Fatalf("cached body = %q, want items", w.Body.String())
+	}
+}
+
+func TestCacheMiddlewareSkipsPrivateResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, cacheControl := range []string{"private", "no-store", "max-age=60, Private"} {
+		r := gin.New()
+		r.GET("/me", CacheMiddleware(time.Minute, nil, NewMemoryCacheStore()), func(c *gin.Context) {
+			c.Header("Cache-Control", cacheControl)
+			c.String(http.StatusOK, "me")
+		})
+
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/me", nil))
+			if got := w.Header().Get(CacheHeader); got != "MISS" {
+				t.Fatalf("Cache-Control %q, request %d: X-Cache = %q, want MISS", cacheControl, i+1, got)
+			}
+		}
+	}
+}
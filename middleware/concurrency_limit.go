@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// ConcurrencyLimitMiddleware caps the number of requests handled at the same time.
+//
+// Unlike the rate limiters (requests over time) this limits in-flight requests, to protect
+// a downstream resource such as a database pool. A request waits up to queueTimeout for
+// a free slot (0 rejects immediately when all slots are busy) and is otherwise rejected
+// with 503 SERVER_BUSY and a Retry-After header. The slot is released when the handler
+// chain returns, also when it panics. A max below 1 allows one request at a time.
+//
+// Example:
+//
+//	// At most 20 concurrent exports, waiting up to 2 seconds for a slot
+//	r.POST("/export", middleware.ConcurrencyLimitMiddleware(20, 2*time.Second), exportHandler)
+func ConcurrencyLimitMiddleware(max int, queueTimeout time.Duration) gin.HandlerFunc {
+	if max < 1 {
+		max = 1
+	}
+	slots := make(chan struct{}, max)
+
+	retryAfter := int(math.Ceil(queueTimeout.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	return func(c *gin.Context) {
+		if !acquireSlot(c, slots, queueTimeout) {
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			helper.ErrorResponse(c, http.StatusServiceUnavailable, "SERVER_BUSY", "Server is busy. Please try again later.")
+			c.Abort()
+			return
+		}
+		defer func() { <-slots }()
+
+		c.Next()
+	}
+}
+
+// acquireSlot takes a slot, waiting up to timeout or until the request is canceled
+func acquireSlot(c *gin.Context, slots chan struct{}, timeout time.Duration) bool {
+	select {
+	case slots <- struct{}{}:
+		return true
+	default:
+	}
+	if timeout <= 0 {
+		return false
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-c.Request.Context().Done():
+		return false
+	}
+}
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// concurrencyRouter returns a router whose /work handler holds its slot until release is closed
+func concurrencyRouter(max int, queueTimeout time.Duration, entered chan<- struct{}, release <-chan struct{}) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(gin.RecoveryWithWriter(io.Discard))
+	r.Use(ConcurrencyLimitMiddleware(max, queueTimeout))
+	r.GET("/work", func(c *gin.Context) {
+		entered <- struct{}{}
+		<-release
+		c.Status(http.StatusOK)
+	})
+	r.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+	r.GET("/fast", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+// serveAsync sends a GET in a goroutine and delivers the recorder on the returned channel
+func serveAsync(r http.Handler, path string) <-chan *httptest.ResponseRecorder {
+	done := make(chan *httptest.ResponseRecorder, 1)
+	go func() {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		done <- w
+	}()
+	return done
+}
+
+func TestConcurrencyLimitRejectsOverflow(t *testing.T) {
+	const limit, extra = 3, 4
+	entered := make(chan struct{}, limit+extra)
+	release := make(chan struct{})
+	r := concurrencyRouter(limit, 0, entered, release)
+
+	results := make([]<-chan *httptest.ResponseRecorder, 0, limit+extra)
+	for i := 0; i < limit; i++ {
+		results = append(results, serveAsync(r, "/work"))
+	}
+	for i := 0; i < limit; i++ {
+		<-entered
+	}
+
+	// Every slot is busy: the extra requests are rejected right away
+	for i := 0; i < extra; i++ {
+		w := <-serveAsync(r, "/work")
+		if w.Code != http.StatusServiceUnavailable {
+			t.Errorf("extra request %d: status = %d, want 503", i, w.Code)
+		}
+		if w.Header().Get("Retry-After") != "1" {
+			t.Errorf("Retry-After = %q, want 1", w.Header().Get("Retry-After"))
+		}
+	}
+
+	close(release)
+	for i, done := range results {
+		if w := <-done; w.Code != http.StatusOK {
+			t.Errorf("request %d: status = %d, want 200", i, w.Code)
+		}
+	}
+}
+
+func TestConcurrencyLimitQueuesWithinTimeout(t *testing.T) {
+	const limit, extra = 2, 2
+	entered := make(chan struct{}, limit+extra)
+	release := make(chan struct{})
+	r := concurrencyRouter(limit, 5*time.Second, entered, release)
+
+	var results []<-chan *httptest.ResponseRecorder
+	for i := 0; i < limit+extra; i++ {
+		results = append(results, serveAsync(r, "/work"))
+	}
+	for i := 0; i < limit; i++ {
+		<-entered
+	}
+
+	// The extra requests are queued, not admitted
+	select {
+	case <-entered:
+		t.Fatal("more than max requests are running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	for i := 0; i < extra; i++ {
+		<-entered
+	}
+	for i, done := range results {
+		if w := <-done; w.Code != http.StatusOK {
+			t.Errorf("request %d: status = %d, want 200", i, w.Code)
+		}
+	}
+}
+
+func TestConcurrencyLimitQueueTimeout(t *testing.T) {
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	r := concurrencyRouter(1, 30*time.Millisecond, entered, release)
+
+	first := serveAsync(r, "/work")
+	<-entered
+
+	start := time.Now()
+	w := <-serveAsync(r, "/fast")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503 after the queue timeout", w.Code)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("rejected after %v, want to wait for the queue timeout", elapsed)
+	}
+
+	close(release)
+	<-first
+}
+
+func TestConcurrencyLimitReleasesOnPanic(t *testing.T) {
+	r := concurrencyRouter(1, 0, nil, nil)
+
+	if w := <-serveAsync(r, "/panic"); w.Code != http.StatusInternalServerError {
+		t.Fatalf("panic status = %d, want 500", w.Code)
+	}
+	if w := <-serveAsync(r, "/fast"); w.Code != http.StatusOK {
+		t.Errorf("status after panic = %d, want 200 (slot must be released)", w.Code)
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireContentTypeMiddleware rejects mutating requests with a Content-Type outside the allow-list.
+//
+// Applies to POST, PUT and PATCH requests that carry a body; other methods and empty bodies pass.
+// Media types are compared case-insensitively without parameters, so "application/json"
+// also allows "application/json; charset=utf-8". Rejected requests get 415 UNSUPPORTED_MEDIA_TYPE.
+// Allowing only application/json blocks cross-site form posts (CSRF), which cannot send JSON.
+//
+// Example:
+//
+//	api := r.Group("/api")
+//	api.Use(middleware.RequireContentTypeMiddleware("application/json"))
+func RequireContentTypeMiddleware(allowed ...string) gin.HandlerFunc {
+	allowList := make([]string, 0, len(allowed))
+	for _, contentType := range allowed {
+		allowList = append(allowList, strings.ToLower(strings.TrimSpace(contentType)))
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			c.Next()
+			return
+		}
+
+		contentType := c.GetHeader("Content-Type")
+		if contentType == "" && c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || !helper.Contains(allowList, strings.ToLower(mediaType)) {
+			helper.ErrorResponse(c, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", "Content-Type must be one of: "+strings.Join(allowed, ", "))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
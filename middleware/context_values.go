@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// ContextValuesMiddleware stores request-scoped values returned by extract in the context.
+//
+// Read the values in handlers with helper.GetValue. A nil or empty map stores nothing.
+//
+// Example:
+//
+//	r.Use(middleware.ContextValuesMiddleware(func(c *gin.Context) map[string]interface{} {
+//	    return map[string]interface{}{
+//	        "tenant_id": c.GetHeader("X-Tenant-ID"),
+//	        "locale":    c.DefaultQuery("lang", "th"),
+//	    }
+//	}))
+//
+//	// In handler
+//	tenantID, ok := helper.GetValue[string](c, "tenant_id")
+func ContextValuesMiddleware(extract func(*gin.Context) map[string]interface{}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for key, value := range extract(c) {
+			helper.SetValue(c, key, value)
+		}
+		c.Next()
+	}
+}
@@ -11,9 +11,47 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 )
 
+// Default CORS values used by CORSMiddleware and for empty CORSConfig fields
+const (
+	DefaultCORSAllowHeaders = "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With"
+	DefaultCORSAllowMethods = "POST, OPTIONS, GET, PUT, PATCH, DELETE"
+)
+
+// CORSConfig configures CORSMiddlewareWithConfig.
+type CORSConfig struct {
+	AllowOrigins     []string // Allowed origins, "*" for any (default any)
+	AllowHeaders     []string // Allowed request headers (default DefaultCORSAllowHeaders)
+	AllowMethods     []string // Allowed methods (default DefaultCORSAllowMethods)
+	AllowCredentials bool     // Send Access-Control-Allow-Credentials: true (requires AllowOrigins)
+}
+
+// errCORSCredentialsAnyOrigin is the panic value of CORSMiddlewareWithConfig for an unsafe config
+var errCORSCredentialsAnyOrigin = errors.New("middleware: CORS AllowCredentials requires an explicit AllowOrigins list without \"*\"")
+
+// validate rejects credentials combined with any origin, which would let every site
+// make cookie-authenticated requests
+func (cfg CORSConfig) validate() error {
+	if !cfg.AllowCredentials {
+		return nil
+	}
+	if len(cfg.AllowOrigins) == 0 {
+		return errCORSCredentialsAnyOrigin
+	}
+	for _, origin := range cfg.AllowOrigins {
+		if origin == "*" {
+			return errCORSCredentialsAnyOrigin
+		}
+	}
+	return nil
+}
+
 // CORSMiddleware handles Cross-Origin Resource Sharing (CORS).
 //
 // Allows all origins, credentials, and common HTTP methods.
@@ -26,8 +64,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", DefaultCORSAllowHeaders)
+		c.Writer.Header().Set("Access-Control-Allow-Methods", DefaultCORSAllowMethods)
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -37,3 +75,67 @@ func CORSMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// CORSMiddlewareWithConfig handles CORS with configurable origins, headers and methods.
+//
+// With a list of origins the request Origin is echoed back only when it is allowed
+// (with Vary: Origin); other origins get no CORS headers, so browsers block them.
+// AllowCredentials requires an explicit list of origins: it panics when AllowOrigins
+// is empty or contains "*", as any site could then make credentialed requests.
+// OPTIONS preflight requests are answered with 204.
+//
+// Example:
+//
+//	r.Use(middleware.CORSMiddlewareWithConfig(middleware.CORSConfig{
+//	    AllowOrigins:     []string{"https://app.example.com"},
+//	    AllowCredentials: true,
+//	}))
+func CORSMiddlewareWithConfig(cfg CORSConfig) gin.HandlerFunc {
+	if err := cfg.validate(); err != nil {
+		panic(err)
+	}
+	allowHeaders := DefaultCORSAllowHeaders
+	if len(cfg.AllowHeaders) > 0 {
+		allowHeaders = strings.Join(cfg.AllowHeaders, ", ")
+	}
+	allowMethods := DefaultCORSAllowMethods
+	if len(cfg.AllowMethods) > 0 {
+		allowMethods = strings.Join(cfg.AllowMethods, ", ")
+	}
+	anyOrigin := len(cfg.AllowOrigins) == 0
+	origins := make(map[string]bool, len(cfg.AllowOrigins))
+	for _, origin := range cfg.AllowOrigins {
+		if origin == "*" {
+			anyOrigin = true
+		}
+		origins[strings.TrimRight(origin, "/")] = true
+	}
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		allowOrigin := "*"
+		if !anyOrigin {
+			header.Add("Vary", "Origin")
+			allowOrigin = c.GetHeader("Origin")
+			if !origins[allowOrigin] {
+				allowOrigin = ""
+			}
+		}
+
+		if allowOrigin != "" {
+			header.Set("Access-Control-Allow-Origin", allowOrigin)
+			if cfg.AllowCredentials {
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+			header.Set("Access-Control-Allow-Headers", allowHeaders)
+			header.Set("Access-Control-Allow-Methods", allowMethods)
+		}
+
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
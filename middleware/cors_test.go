@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCORSMiddlewareWithConfigOrigins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		cfg        CORSConfig
+		origin     string
+		wantOrigin string
+		wantVary   bool
+	}{
+		{"any origin", CORSConfig{}, "https://a.example.com", "*", false},
+		{"wildcard", CORSConfig{AllowOrigins: []string{"*"}}, "https://a.example.com", "*", false},
+		{"listed origin", CORSConfig{AllowOrigins: []string{"https://a.example.com/"}}, "https://a.example.com", "https://a.example.com", true},
+		{"listed origin with credentials", CORSConfig{AllowOrigins: []string{"https://a.example.com"}, AllowCredentials: true}, "https://a.example.com", "https://a.example.com", true},
+		{"unlisted origin", CORSConfig{AllowOrigins: []string{"https://a.example.com"}, AllowCredentials: true}, "https://evil.example.com", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(CORSMiddlewareWithConfig(tt.cfg))
+			r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Origin", tt.origin)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := w.Header().Get("Vary") == "Origin"; got != tt.wantVary {
+				t.Errorf("Vary: Origin = %v, want %v", got, tt.wantVary)
+			}
+			credentials := w.Header().Get("Access-Control-Allow-Credentials")
+			if tt.wantOrigin != "" && tt.cfg.AllowCredentials && credentials != "true" {
+				t.Errorf("Access-Control-Allow-Credentials = %q, want true", credentials)
+			}
+			if credentials == "true" && w.Header().Get("Access-Control-Allow-Origin") == "*" {
+				t.Error("credentials must not be combined with a wildcard origin")
+			}
+		})
+	}
+}
+
+func TestCORSMiddlewareWithConfigRejectsCredentialsForAnyOrigin(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  CORSConfig
+	}{
+		{"any origin with credentials", CORSConfig{AllowCredentials: true}},
+		{"wildcard with credentials", CORSConfig{AllowOrigins: []string{"https://a.example.com", "*"}, AllowCredentials: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("CORSMiddlewareWithConfig did not panic")
+				}
+			}()
+			CORSMiddlewareWithConfig(tt.cfg)
+		})
+	}
+}
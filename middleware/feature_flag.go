@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireFeatureMiddleware hides a route behind a flag in helper.DefaultFeatureFlags.
+//
+// Returns 404 NOT_FOUND when the flag is off for the current user, so dark-launched
+// endpoints look like they do not exist. Apply after authentication to honor
+// per-user overrides and percentage rollouts.
+//
+// Example:
+//
+//	helper.DefaultFeatureFlags.SetRollout("new-checkout", 10)
+//	r.POST("/checkout/v2", middleware.RequireFeatureMiddleware("new-checkout"), checkoutV2)
+func RequireFeatureMiddleware(name string) gin.HandlerFunc {
+	return RequireFeatureWithFlagsMiddleware(helper.DefaultFeatureFlags, name)
+}
+
+// RequireFeatureWithFlagsMiddleware hides a route behind a flag in a custom store.
+//
+// Example:
+//
+//	flags := helper.NewFeatureFlags()
+//	flags.Enable("reports")
+//	r.GET("/reports", middleware.RequireFeatureWithFlagsMiddleware(flags, "reports"), listReports)
+func RequireFeatureWithFlagsMiddleware(flags *helper.FeatureFlags, name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !flags.IsEnabled(name, c) {
+			helper.ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "Resource not found")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,310 @@
+package middleware
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/convert"
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	timestampType = reflect.TypeOf(helper.Timestamp{})
+)
+
+// BindParams binds the parsed "params" from context into target.
+//
+// Must be used after InputForm (or Form). Form values arrive as strings for
+// multipart and urlencoded requests, so each value is coerced into the field type.
+// See DecodeParams for supported types and tags.
+//
+// Example:
+//
+//	type CreateUser struct {
+//	    Name     string    `form:"name"`
+//	    Age      int       `form:"age"`
+//	    Active   bool      `form:"active"`
+//	    Birthday time.Time `form:"birthday"`
+//	}
+//
+//	var req CreateUser
+//	if err := middleware.BindParams(c, &req); err != nil {
+//	    helper.ValidationErrorResponse(c, err)
+//	    return
+//	}
+func BindParams(c *gin.Context, target interface{}) error {
+	params := map[string]any{}
+	if value, exists := c.Get(helper.ContextKeyParams); exists {
+		if m, ok := value.(map[string]any); ok {
+			params = m
+		}
+	}
+	return DecodeParams(params, target)
+}
+
+// BindForm parses the request body like InputForm and decodes it into a new T in one step.
+//
+// JSON, multipart and urlencoded bodies are all supported (see Form), and values are
+// coerced as in DecodeParams. If InputForm already ran, its "params" are reused.
+// A field that cannot be decoded is returned as a *helper.ValidationError with a dotted
+// field path (e.g. "address.zip"), ready for helper.ValidationErrorResponse.
+//
+// Example:
+//
+//	type CreateUser struct {
+//	    Name string `json:"name"`
+//	    Age  int    `json:"age"`
+//	}
+//
+//	req, err := middleware.BindForm[CreateUser](c)
+//	if err != nil {
+//	    helper.ValidationErrorResponse(c, err)
+//	    return
+//	}
+func BindForm[T any](c *gin.Context) (T, error) {
+	var target T
+	if _, exists := c.Get(helper.ContextKeyParams); !exists {
+		if err := Form(c); err != nil {
+			return target, err
+		}
+	}
+
+	if err := BindParams(c, &target); err != nil {
+		var fieldErr *paramFieldError
+		if errors.As(err, &fieldErr) {
+			return target, fieldErr.validationError()
+		}
+		return target, err
+	}
+	return target, nil
+}
+
+// DecodeParams coerces a params map into the struct pointed to by target.
+//
+// Field names come from the `form` tag, then the `json` tag, then the field name.
+// A tag of "-" skips the field. Supported field types are strings, ints, uints,
+// floats, bools (convert.ToBoolStrict forms, e.g. "on"), time.Time and helper.Timestamp
+// (helper.TimestampLayout or RFC3339), encoding.TextUnmarshaler (e.g. uuid.UUID),
+// nested structs, pointers and slices of these.
+//
+// Example:
+//
+//	params := map[string]any{"age": "30", "active": "true"}
+//	var req CreateUser
+//	err := middleware.DecodeParams(params, &req) // req.Age = 30, req.Active = true
+func DecodeParams(params map[string]any, target interface{}) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("target must be a non-nil pointer to struct")
+	}
+	return decodeStruct(params, rv.Elem())
+}
+
+// decodeStruct assigns map values to the exported fields of a struct value
+func decodeStruct(params map[string]any, rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := paramFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		value, ok := params[name]
+		if !ok {
+			continue
+		}
+
+		if err := decodeValue(value, rv.Field(i)); err != nil {
+			return &paramFieldError{field: name, fieldType: field.Type, err: err}
+		}
+	}
+	return nil
+}
+
+// paramFieldError is a decode failure of a struct field, possibly wrapping a nested one
+type paramFieldError struct {
+	field     string
+	fieldType reflect.Type
+	err       error
+}
+
+func (e *paramFieldError) Error() string {
+	return fmt.Sprintf("field '%s': %v", e.field, e.err)
+}
+
+func (e *paramFieldError) Unwrap() error {
+	return e.err
+}
+
+// validationError converts the failure to a helper.ValidationError with a dotted field path
+func (e *paramFieldError) validationError() *helper.ValidationError {
+	path, code, cause := e.field, validationCodeForType(e.fieldType), e.err
+	for {
+		var nested *paramFieldError
+		if !errors.As(cause, &nested) {
+			break
+		}
+		path, code, cause = path+"."+nested.field, validationCodeForType(nested.fieldType), nested.err
+	}
+	return helper.NewValidationError(code, path, fmt.Sprintf("field '%s': %v", path, cause))
+}
+
+// validationCodeForType returns the validation code for a value that cannot be decoded into t
+func validationCodeForType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType, timestampType:
+		return helper.ValidationCodeInvalidFormat
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return helper.ValidationCodeNotInt
+	case reflect.Float32, reflect.Float64:
+		return helper.ValidationCodeNotFloat
+	case reflect.Bool:
+		return helper.ValidationCodeNotBool
+	case reflect.Struct:
+		return helper.ValidationCodeNotMap
+	}
+	return helper.ValidationCodeInvalidFormat
+}
+
+// paramFieldName returns the params key for a struct field
+func paramFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"form", "json"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(tag), ","); name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// decodeValue coerces value into the settable destination dst
+func decodeValue(value interface{}, dst reflect.Value) error {
+	if value == nil {
+		return nil
+	}
+
+	if dst.Kind() == reflect.Ptr {
+		elem := reflect.New(dst.Type().Elem())
+		if err := decodeValue(value, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	switch dst.Type() {
+	case timeType, timestampType:
+		t, err := parseParamTime(value)
+		if err != nil {
+			return err
+		}
+		dst.Set(reflect.ValueOf(t).Convert(dst.Type()))
+		return nil
+	}
+
+	if dst.CanAddr() {
+		if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(convert.ToString(value)))
+		}
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(convert.ToString(value))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := convert.ToInt64(value)
+		if err != nil {
+			return err
+		}
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, dst.Type())
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := convert.ToInt64(value)
+		if err != nil {
+			return err
+		}
+		if n < 0 || dst.OverflowUint(uint64(n)) {
+			return fmt.Errorf("value %d overflows %s", n, dst.Type())
+		}
+		dst.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := convert.ToFloat64(value)
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.Bool:
+		if s, ok := value.(string); ok && s == "" {
+			dst.SetBool(false)
+			return nil
+		}
+		b, err := convert.ToBoolStrict(value)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(b)
+	case reflect.Slice:
+		items, ok := value.([]interface{})
+		if !ok {
+			items = []interface{}{value}
+		}
+		slice := reflect.MakeSlice(dst.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeValue(item, slice.Index(i)); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+		dst.Set(slice)
+	case reflect.Struct:
+		m, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+		}
+		return decodeStruct(m, dst)
+	case reflect.Interface:
+		if value == nil || !reflect.TypeOf(value).AssignableTo(dst.Type()) {
+			return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+		}
+		dst.Set(reflect.ValueOf(value))
+	default:
+		return fmt.Errorf("unsupported field type %s", dst.Type())
+	}
+	return nil
+}
+
+// parseParamTime parses a form value using the Timestamp layout or RFC3339
+func parseParamTime(value interface{}) (time.Time, error) {
+	s := convert.ToString(value)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	loc, err := time.LoadLocation(helper.TZ)
+	if err != nil {
+		loc = time.Local
+	}
+	if t, err := time.ParseInLocation(helper.TimestampLayout, s, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q as time, expected layout %q or RFC3339", s, helper.TimestampLayout)
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDecodeParamsInterfaceFields(t *testing.T) {
+	type target struct {
+		Any      any          `form:"any"`
+		Stringer fmt.Stringer `form:"stringer"`
+	}
+
+	var req target
+	if err := DecodeParams(map[string]any{"any": "x", "stringer": nil}, &req); err != nil {
+		t.Fatalf("DecodeParams() = %v", err)
+	}
+	if req.Any != "x" || req.Stringer != nil {
+		t.Errorf("DecodeParams() = %+v", req)
+	}
+
+	err := DecodeParams(map[string]any{"stringer": "not a Stringer"}, &req)
+	if err == nil || !strings.Contains(err.Error(), "field 'stringer'") {
+		t.Errorf("DecodeParams() = %v, want a field error for stringer", err)
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// ContextKeyFormFiles is the Gin context key for files opened by FormFileStreamsMiddleware.
+const ContextKeyFormFiles = "form_files"
+
+// FormFile is an uploaded multipart file opened for reading.
+type FormFile struct {
+	io.ReadCloser
+	Field       string // Form field name
+	Filename    string // Client-provided file name
+	ContentType string // Content-Type sent by the client (use helper.GetMimeType to sniff)
+	Size        int64  // Size in bytes
+}
+
+// FormFileStreamsMiddleware opens every uploaded file of a multipart request and stores
+// them by field name, so handlers can read each file once without calling Open/Close.
+//
+// Lifetime: the files are closed automatically when the rest of the handler chain
+// returns. Read them inside the handler; do not keep them for goroutines that outlive
+// the request. Closing a file early is allowed.
+// Non-multipart requests pass through unchanged. A malformed form returns 400 and a
+// file that cannot be opened returns 500.
+//
+// Example:
+//
+//	r.POST("/import", middleware.FormFileStreamsMiddleware(), func(c *gin.Context) {
+//	    for _, file := range middleware.GetFormFiles(c, "files") {
+//	        if err := client.UploadFileWithReader("my-bucket", file.Filename, file, file.Size, file.ContentType, ""); err != nil {
+//	            helper.ErrorResponse(c, 500, "UPLOAD_FAILED", err.Error())
+//	            return
+//	        }
+//	    }
+//	    helper.SuccessResponse(c, 200, nil)
+//	})
+func FormFileStreamsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.Contains(c.GetHeader("Content-Type"), "multipart/form-data") {
+			c.Next()
+			return
+		}
+
+		form, err := c.MultipartForm()
+		if err != nil {
+			helper.ErrorResponse(c, http.StatusBadRequest, "INVALID_FORM", "Unable to parse multipart form")
+			c.Abort()
+			return
+		}
+
+		files := make(map[string][]*FormFile, len(form.File))
+		defer func() {
+			for _, list := range files {
+				for _, file := range list {
+					file.Close()
+				}
+			}
+		}()
+
+		for field, headers := range form.File {
+			for _, header := range headers {
+				reader, err := header.Open()
+				if err != nil {
+					helper.ErrorResponse(c, http.StatusInternalServerError, "FILE_OPEN_FAILED", "Unable to open uploaded file")
+					c.Abort()
+					return
+				}
+				files[field] = append(files[field], &FormFile{
+					ReadCloser:  reader,
+					Field:       field,
+					Filename:    header.Filename,
+					ContentType: header.Header.Get("Content-Type"),
+					Size:        header.Size,
+				})
+			}
+		}
+
+		c.Set(ContextKeyFormFiles, files)
+		c.Next()
+	}
+}
+
+// GetFormFiles returns the files opened by FormFileStreamsMiddleware for a form field.
+//
+// Returns nil if the field has no files or the middleware was not applied.
+func GetFormFiles(c *gin.Context, field string) []*FormFile {
+	files, _ := helper.GetValue[map[string][]*FormFile](c, ContextKeyFormFiles)
+	return files[field]
+}
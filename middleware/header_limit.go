@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderLimitMiddleware rejects requests with oversized or suspicious headers.
+//
+// Requests whose headers exceed maxHeaderBytes in total (name, value and framing per
+// line) or maxHeaders values are rejected with 431 REQUEST_HEADERS_TOO_LARGE; a limit of
+// 0 or less disables that check. Header names or values containing control characters
+// (other than tab) are rejected with 400 INVALID_HEADER, so they cannot inject lines into
+// logs, e.g. through X-Request-ID. http.Server.MaxHeaderBytes still applies first.
+//
+// Example:
+//
+//	// At most 8 KB of headers in at most 50 lines
+//	r.Use(middleware.HeaderLimitMiddleware(8<<10, 50))
+func HeaderLimitMiddleware(maxHeaderBytes int, maxHeaders int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		total, count := 0, 0
+		for name, values := range c.Request.Header {
+			if hasControlChars(name) {
+				rejectInvalidHeader(c)
+				return
+			}
+			for _, value := range values {
+				if hasControlChars(value) {
+					rejectInvalidHeader(c)
+					return
+				}
+				total += len(name) + len(value) + len(": \r\n")
+				count++
+			}
+		}
+
+		if (maxHeaderBytes > 0 && total > maxHeaderBytes) || (maxHeaders > 0 && count > maxHeaders) {
+			helper.ErrorResponse(c, http.StatusRequestHeaderFieldsTooLarge, "REQUEST_HEADERS_TOO_LARGE", "Request headers are too large")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// hasControlChars reports ASCII control characters other than tab
+func hasControlChars(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return (r < 0x20 && r != '\t') || r == 0x7f
+	}) >= 0
+}
+
+// rejectInvalidHeader aborts with 400 INVALID_HEADER
+func rejectInvalidHeader(c *gin.Context) {
+	helper.ErrorResponse(c, http.StatusBadRequest, "INVALID_HEADER", "Request headers contain invalid characters")
+	c.Abort()
+}
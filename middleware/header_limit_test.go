@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHeaderLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		maxBytes   int
+		maxHeaders int
+		headers    map[string][]string
+		wantStatus int
+		wantCode   string
+	}{
+		{"within limits", 1024, 10, map[string][]string{"X-Request-Id": {"abc"}}, http.StatusOK, ""},
+		{"oversized value", 1024, 10, map[string][]string{"X-Big": {strings.Repeat("a", 2048)}}, http.StatusRequestHeaderFieldsTooLarge, "REQUEST_HEADERS_TOO_LARGE"},
+		{"too many values", 0, 3, map[string][]string{"X-A": {"1", "2"}, "X-B": {"3", "4"}}, http.StatusRequestHeaderFieldsTooLarge, "REQUEST_HEADERS_TOO_LARGE"},
+		{"limits disabled", 0, 0, map[string][]string{"X-Big": {strings.Repeat("a", 2048)}}, http.StatusOK, ""},
+		{"newline injected value", 1024, 10, map[string][]string{"X-Request-Id": {"abc\r\nX-Admin: true"}}, http.StatusBadRequest, "INVALID_HEADER"},
+		{"bare newline", 1024, 10, map[string][]string{"User-Agent": {"bot\nfake log line"}}, http.StatusBadRequest, "INVALID_HEADER"},
+		{"control char in name", 1024, 10, map[string][]string{"X-Bad\x00": {"v"}}, http.StatusBadRequest, "INVALID_HEADER"},
+		{"tab allowed", 1024, 10, map[string][]string{"X-Tabbed": {"a\tb"}}, http.StatusOK, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(HeaderLimitMiddleware(tt.maxBytes, tt.maxHeaders))
+			r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for name, values := range tt.headers {
+				req.Header[name] = values
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			json.Unmarshal(w.Body.Bytes(), &body)
+			if body.Error.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Error.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHeaderLimitMiddlewareCountsFraming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	// "X-A: 1234\r\n" is 11 bytes
+	r.Use(HeaderLimitMiddleware(11, 0))
+	r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for value, want := range map[string]int{"1234": http.StatusOK, "12345": http.StatusRequestHeaderFieldsTooLarge} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header = http.Header{"X-A": {value}}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != want {
+			t.Errorf("X-A: %s: status = %d, want %d", value, w.Code, want)
+		}
+	}
+}
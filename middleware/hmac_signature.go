@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultSignatureHeader is the header read when HMACConfig.Header is empty.
+const DefaultSignatureHeader = "X-Signature"
+
+// HMACConfig configures HMACSignatureMiddlewareWithConfig.
+type HMACConfig struct {
+	Secret string // Shared secret (required)
+	Header string // Header carrying the hex signature (default X-Signature)
+
+	// TimestampHeader includes a timestamp in the signed payload when set (e.g. "X-Timestamp").
+	// The payload becomes "<timestamp>.<body>", so a captured signature cannot be reused with
+	// another timestamp. Combine with ReplayProtectionMiddleware to reject stale timestamps.
+	TimestampHeader string
+
+	// NonceHeader includes a nonce in the signed payload when set (e.g. "X-Nonce").
+	// With both headers the payload is "<timestamp>.<nonce>.<body>", so a captured request
+	// cannot be replayed under a fresh nonce. Required for ReplayProtectionMiddleware.
+	NonceHeader string
+
+	// MaxBodyBytes limits the body read for verification (default MaxRawBodySize).
+	// Larger bodies are rejected with 413 before any signature check.
+	MaxBodyBytes int64
+}
+
+// HMACSignatureMiddleware verifies an HMAC-SHA256 signature over the raw request body.
+//
+// The signature is read from headerName as hex, optionally prefixed with "sha256=".
+// Mismatches are rejected with 401, bodies over MaxRawBodySize with 413, and the
+// body is restored for the handler.
+//
+// Example:
+//
+//	webhook := r.Group("/webhook")
+//	webhook.Use(middleware.HMACSignatureMiddleware("partner-secret", "X-Signature"))
+func HMACSignatureMiddleware(secret string, headerName string) gin.HandlerFunc {
+	return HMACSignatureMiddlewareWithConfig(HMACConfig{Secret: secret, Header: headerName})
+}
+
+// HMACSignatureMiddlewareWithConfig verifies an HMAC-SHA256 signature with a configurable scheme.
+//
+// Example:
+//
+//	// Partner signs HMAC(secret, timestamp + "." + nonce + "." + body)
+//	webhook.Use(middleware.HMACSignatureMiddlewareWithConfig(middleware.HMACConfig{
+//	    Secret:          "partner-secret",
+//	    Header:          "X-Signature",
+//	    TimestampHeader: "X-Timestamp",
+//	    NonceHeader:     "X-Nonce",
+//	}))
+func HMACSignatureMiddlewareWithConfig(cfg HMACConfig) gin.HandlerFunc {
+	if cfg.Header == "" {
+		cfg.Header = DefaultSignatureHeader
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = MaxRawBodySize
+	}
+
+	return func(c *gin.Context) {
+		signature := c.GetHeader(cfg.Header)
+		if signature == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_SIGNATURE", cfg.Header+" header is required")
+			c.Abort()
+			return
+		}
+
+		var timestamp string
+		if cfg.TimestampHeader != "" {
+			timestamp = c.GetHeader(cfg.TimestampHeader)
+			if timestamp == "" {
+				helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_TIMESTAMP", cfg.TimestampHeader+" header is required")
+				c.Abort()
+				return
+			}
+		}
+
+		var nonce string
+		if cfg.NonceHeader != "" {
+			nonce = c.GetHeader(cfg.NonceHeader)
+			if nonce == "" {
+				helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_NONCE", cfg.NonceHeader+" header is required")
+				c.Abort()
+				return
+			}
+		}
+
+		body, err := helper.ReadAndRestoreBody(c, cfg.MaxBodyBytes)
+		if errors.Is(err, helper.ErrBodyTooLarge) {
+			helper.ErrorResponse(c, http.StatusRequestEntityTooLarge, "BODY_TOO_LARGE", "Request body is too large")
+			c.Abort()
+			return
+		}
+		if err != nil {
+			helper.ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "Unable to read request body")
+			c.Abort()
+			return
+		}
+
+		var prefix string
+		if cfg.TimestampHeader != "" {
+			prefix += timestamp + "."
+		}
+		if cfg.NonceHeader != "" {
+			prefix += nonce + "."
+		}
+		payload := append([]byte(prefix), body...)
+
+		if !helper.VerifyHMACSHA256(cfg.Secret, payload, signature) {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_SIGNATURE", "Signature does not match")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// JSONSchemaConfig configures JSONSchemaMiddlewareWithConfig.
+type JSONSchemaConfig struct {
+	Schema       string // JSON Schema document (required)
+	MaxBodyBytes int64  // Maximum body size to validate (default MaxRawBodySize)
+}
+
+// JSONSchemaMiddleware validates the JSON request body against a JSON Schema.
+//
+// Invalid payloads are rejected with a 400 VALIDATION_ERROR response whose
+// details list each failing field path, and bodies over MaxRawBodySize with 413.
+// The body is restored afterwards so handlers and later middlewares can read it again.
+//
+// Example:
+//
+//	schema := `{
+//	    "type": "object",
+//	    "required": ["name"],
+//	    "properties": {"name": {"type": "string"}, "age": {"type": "integer"}}
+//	}`
+//	r.POST("/users", middleware.JSONSchemaMiddleware(schema), createUser)
+func JSONSchemaMiddleware(schema string) gin.HandlerFunc {
+	return JSONSchemaMiddlewareWithConfig(JSONSchemaConfig{Schema: schema})
+}
+
+// JSONSchemaMiddlewareWithConfig validates the JSON request body with a configurable body limit.
+//
+// Example:
+//
+//	r.POST("/imports", middleware.JSONSchemaMiddlewareWithConfig(middleware.JSONSchemaConfig{
+//	    Schema:       importSchema,
+//	    MaxBodyBytes: 1 << 20,
+//	}), createImport)
+func JSONSchemaMiddlewareWithConfig(cfg JSONSchemaConfig) gin.HandlerFunc {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = MaxRawBodySize
+	}
+
+	return func(c *gin.Context) {
+		body, err := helper.ReadAndRestoreBody(c, cfg.MaxBodyBytes)
+		if errors.Is(err, helper.ErrBodyTooLarge) {
+			helper.ErrorResponse(c, http.StatusRequestEntityTooLarge, "BODY_TOO_LARGE", "Request body is too large")
+			c.Abort()
+			return
+		}
+		if err != nil {
+			helper.ValidationErrorResponse(c, err)
+			c.Abort()
+			return
+		}
+
+		if err := helper.ValidateJSONSchema(body, cfg.Schema); err != nil {
+			helper.ValidationErrorResponse(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
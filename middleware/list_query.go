@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ContextKeyListQuery is the Gin context key for the ListQuery parsed by ListQueryMiddleware.
+const ContextKeyListQuery = "list_query"
+
+// ListConfig configures ListQueryMiddleware.
+type ListConfig struct {
+	DefaultLimit int               // Limit when ?limit is missing (default 20)
+	MaxLimit     int               // Largest allowed ?limit (default 100)
+	SortFields   []string          // Columns allowed in ?sort=name,-created_at
+	DefaultSort  string            // Sort expression used when ?sort is missing
+	Filters      map[string]string // Query parameter -> column for equality/IN filters
+}
+
+// ListQuery is the validated list query stored by ListQueryMiddleware.
+type ListQuery struct {
+	Paginator helper.Paginator
+	Sort      []helper.SortField
+	Filter    func(*gorm.DB) *gorm.DB // Filter scope (applies to count and data query)
+	Order     func(*gorm.DB) *gorm.DB // Sort load scope (data query only)
+}
+
+// Scopes returns the filter and sort scopes for PaginateGORMWithScopes.
+func (q *ListQuery) Scopes() []func(*gorm.DB) *gorm.DB {
+	return []func(*gorm.DB) *gorm.DB{q.Filter, q.Order}
+}
+
+// ListQueryMiddleware parses and validates page, limit, sort and filter query parameters.
+//
+// Invalid input (non-numeric page, limit above MaxLimit, sort field not in SortFields)
+// is rejected with a 400 validation error. Retrieve the result with GetListQuery.
+//
+// Example:
+//
+//	users.GET("", middleware.ListQueryMiddleware(middleware.ListConfig{
+//	    SortFields:  []string{"name", "created_at"},
+//	    DefaultSort: "-created_at",
+//	    Filters:     map[string]string{"status": "status"},
+//	}), listUsers)
+//
+//	func listUsers(c *gin.Context) {
+//	    query, _ := middleware.GetListQuery(c)
+//	    var users []User
+//	    if err := query.Paginator.PaginateGORMWithScopes(db.Model(&User{}), &users, query.Scopes()...); err != nil {
+//	        helper.ErrorResponse(c, 500, "INTERNAL_SERVER_ERROR", "Unable to list users")
+//	        return
+//	    }
+//	    helper.SuccessResponseWithMeta(c, 200, users, map[string]interface{}{"pagination": query.Paginator})
+//	}
+func ListQueryMiddleware(cfg ListConfig) gin.HandlerFunc {
+	if cfg.DefaultLimit <= 0 {
+		cfg.DefaultLimit = 20
+	}
+	if cfg.MaxLimit <= 0 {
+		cfg.MaxLimit = 100
+	}
+
+	return func(c *gin.Context) {
+		paginator, err := helper.NewPaginatorFromQuery(c, cfg.DefaultLimit, cfg.MaxLimit)
+		if err != nil {
+			helper.ValidationErrorResponse(c, err)
+			c.Abort()
+			return
+		}
+
+		sortValue := c.Query("sort")
+		if sortValue == "" {
+			sortValue = cfg.DefaultSort
+		}
+		sorts, err := helper.ParseSort(sortValue, cfg.SortFields)
+		if err != nil {
+			helper.ValidationErrorResponse(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(ContextKeyListQuery, &ListQuery{
+			Paginator: paginator,
+			Sort:      sorts,
+			Filter:    helper.FilterScope(c, cfg.Filters),
+			Order:     helper.SortScope(sorts),
+		})
+
+		c.Next()
+	}
+}
+
+// GetListQuery retrieves the ListQuery stored by ListQueryMiddleware.
+func GetListQuery(c *gin.Context) (*ListQuery, bool) {
+	return helper.GetValue[*ListQuery](c, ContextKeyListQuery)
+}
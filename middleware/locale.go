@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"strings"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// TimezoneHeader is the HTTP header carrying the caller's IANA time zone (e.g. "Asia/Tokyo").
+const TimezoneHeader = "X-Timezone"
+
+// LocaleMiddleware resolves the caller's locale and time zone.
+//
+// The locale is the highest-weighted language in Accept-Language (e.g. "en-US"),
+// falling back to helper.DefaultLocale. The time zone is loaded from the X-Timezone
+// header, falling back to helper.DefaultLocation() when absent or invalid.
+// Read them with helper.GetRequestLocale and helper.GetRequestLocation.
+//
+// Example:
+//
+//	r.Use(middleware.LocaleMiddleware())
+//
+//	// In handler
+//	createdAt := helper.TimestampInRequestLocation(c, order.CreatedAt)
+func LocaleMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := helper.PreferredLanguage(c, nil, helper.DefaultLocale)
+		helper.SetValue(c, helper.ContextKeyLocale, locale)
+
+		loc := helper.DefaultLocation()
+		if tz := strings.TrimSpace(c.GetHeader(TimezoneHeader)); tz != "" {
+			if parsed, err := time.LoadLocation(tz); err == nil {
+				loc = parsed
+			}
+		}
+		helper.SetValue(c, helper.ContextKeyLocation, loc)
+
+		c.Next()
+	}
+}
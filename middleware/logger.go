@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/AECInfraconnect/go-module-helper/helper"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
@@ -170,3 +171,41 @@ func DetailedLoggerMiddleware(logger *logrus.Logger) gin.HandlerFunc {
 		}
 	}
 }
+
+// SlowRequestLoggerMiddleware logs only requests slower than threshold, at Warn level.
+//
+// Entries include the route template (e.g. "/users/:id") so slow endpoints can be
+// aggregated regardless of path parameters.
+//
+// Example:
+//
+//	r.Use(middleware.SlowRequestLoggerMiddleware(logger, 500*time.Millisecond))
+func SlowRequestLoggerMiddleware(logger *logrus.Logger, threshold time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := time.Now()
+
+		c.Next()
+
+		latency := time.Since(startTime)
+		if latency < threshold {
+			return
+		}
+
+		entry := logger.WithFields(logrus.Fields{
+			"request_id":   GetRequestID(c),
+			"method":       c.Request.Method,
+			"path":         c.Request.URL.Path,
+			"route":        c.FullPath(),
+			"status":       c.Writer.Status(),
+			"latency":      latency.String(),
+			"latency_ms":   latency.Milliseconds(),
+			"threshold_ms": threshold.Milliseconds(),
+		})
+
+		if userID, ok := helper.GetUserIDStringFromContext(c); ok {
+			entry = entry.WithField("user_id", userID)
+		}
+
+		entry.Warn(fmt.Sprintf("Slow request: %s %s - %s", c.Request.Method, c.FullPath(), latency))
+	}
+}
@@ -1,7 +1,9 @@
 package middleware
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -83,13 +85,32 @@ func (s *RateLimiterStore) GetLimiter(clientID string, maxTokens int, refillRate
 // Returns true if a token is available and consumes it.
 // Returns false if no tokens are available.
 func (r *RateLimiter) Allow() bool {
+	return r.AllowN(1)
+}
+
+// AllowN checks if a request costing n tokens is allowed under the current rate limit.
+//
+// Returns true and consumes n tokens if enough are available.
+// Returns false without consuming anything otherwise.
+func (r *RateLimiter) AllowN(n int) bool {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	now := time.Now()
+	r.refill(time.Now())
+
+	// Check if we have enough tokens available
+	if r.tokens >= n {
+		r.tokens -= n
+		return true
+	}
+
+	return false
+}
+
+// refill adds tokens based on elapsed time. Caller must hold r.mu.
+func (r *RateLimiter) refill(now time.Time) {
 	elapsed := now.Sub(r.lastRefillTime)
 
-	// Refill tokens based on elapsed time
 	tokensToAdd := int(elapsed / r.refillRate)
 	if tokensToAdd > 0 {
 		r.tokens += tokensToAdd
@@ -98,35 +119,50 @@ func (r *RateLimiter) Allow() bool {
 		}
 		r.lastRefillTime = now
 	}
+}
 
-	// Check if we have tokens available
-	if r.tokens > 0 {
-		r.tokens--
-		return true
-	}
+// retryAfter returns how long until n tokens will be available.
+func (r *RateLimiter) retryAfter(n int) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	return false
+	if n > r.maxTokens {
+		n = r.maxTokens
+	}
+	missing := n - r.tokens
+	if missing <= 0 {
+		return 0
+	}
+	wait := time.Duration(missing)*r.refillRate - time.Since(r.lastRefillTime)
+	if wait < 0 {
+		return 0
+	}
+	return wait
 }
 
 // RateLimitMiddleware creates a rate limiting middleware based on user ID or IP.
 //
 // Limits requests per authenticated user (if JWT middleware is used) or per IP address.
+// Pass trustedProxies to resolve the IP via helper.RealClientIP instead of c.ClientIP().
 //
 // Example:
 //
 //	// 100 requests per minute
 //	r.Use(middleware.RateLimitMiddleware(100, time.Minute))
-func RateLimitMiddleware(maxRequests int, window time.Duration) gin.HandlerFunc {
+//
+//	// Behind a load balancer in 10.0.0.0/8
+//	r.Use(middleware.RateLimitMiddleware(100, time.Minute, "10.0.0.0/8"))
+func RateLimitMiddleware(maxRequests int, window time.Duration, trustedProxies ...string) gin.HandlerFunc {
 	store := NewRateLimiterStore()
 	refillRate := window / time.Duration(maxRequests)
 
 	return func(c *gin.Context) {
 		// Get client identifier (IP address or user ID if authenticated)
-		clientID := c.ClientIP()
+		clientID := helper.RealClientIP(c, trustedProxies)
 
 		// If user is authenticated, use user ID instead
-		if userID, exists := c.Get(helper.ContextKeyUserID); exists {
-			clientID = userID.(string)
+		if userID, ok := helper.GetUserIDStringFromContext(c); ok {
+			clientID = userID
 		}
 
 		// Get or create limiter for this client
@@ -144,17 +180,21 @@ func RateLimitMiddleware(maxRequests int, window time.Duration) gin.HandlerFunc
 }
 
 // IPRateLimitMiddleware creates a rate limiting middleware based solely on IP address.
+// Pass trustedProxies to resolve the IP via helper.RealClientIP instead of c.ClientIP().
 //
 // Example:
 //
 //	// 1000 requests per hour per IP
 //	r.Use(middleware.IPRateLimitMiddleware(1000, time.Hour))
-func IPRateLimitMiddleware(maxRequests int, window time.Duration) gin.HandlerFunc {
+//
+//	// Only trust X-Forwarded-For set by the load balancer
+//	r.Use(middleware.IPRateLimitMiddleware(1000, time.Hour, "10.0.0.0/8"))
+func IPRateLimitMiddleware(maxRequests int, window time.Duration, trustedProxies ...string) gin.HandlerFunc {
 	store := NewRateLimiterStore()
 	refillRate := window / time.Duration(maxRequests)
 
 	return func(c *gin.Context) {
-		clientID := c.ClientIP()
+		clientID := helper.RealClientIP(c, trustedProxies)
 		limiter := store.GetLimiter(clientID, maxRequests, refillRate)
 
 		if !limiter.Allow() {
@@ -230,3 +270,117 @@ func CustomRateLimitMiddleware(maxRequests int, window time.Duration, getClientI
 		c.Next()
 	}
 }
+
+// DefaultRateRuleKey is the rules key used as fallback for routes without their own rule.
+const DefaultRateRuleKey = "*"
+
+// RateRule defines the request limit for a single route.
+type RateRule struct {
+	MaxRequests int           // Maximum number of requests per window
+	Window      time.Duration // Time window for MaxRequests
+}
+
+// RouteRateLimitMiddleware applies different rate limits per route.
+//
+// Keys are gin route patterns as returned by c.FullPath() (e.g. "/auth/login").
+// Routes without a rule use the DefaultRateRuleKey rule, or are not limited if none is set.
+// All routes share a single RateLimiterStore keyed by route and client (user ID or IP).
+// Pass trustedProxies to resolve the IP via helper.RealClientIP instead of c.ClientIP().
+//
+// Example:
+//
+//	r.Use(middleware.RouteRateLimitMiddleware(map[string]middleware.RateRule{
+//	    "/auth/login":                {MaxRequests: 5, Window: time.Minute},
+//	    "/users/:id":                 {MaxRequests: 300, Window: time.Minute},
+//	    middleware.DefaultRateRuleKey: {MaxRequests: 100, Window: time.Minute},
+//	}, "10.0.0.0/8"))
+func RouteRateLimitMiddleware(rules map[string]RateRule, trustedProxies ...string) gin.HandlerFunc {
+	store := NewRateLimiterStore()
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		rule, ok := rules[route]
+		if !ok {
+			if rule, ok = rules[DefaultRateRuleKey]; !ok {
+				c.Next()
+				return
+			}
+			route = DefaultRateRuleKey
+		}
+		if rule.MaxRequests <= 0 {
+			c.Next()
+			return
+		}
+
+		clientID := helper.RealClientIP(c, trustedProxies)
+		if userID, ok := helper.GetUserIDStringFromContext(c); ok {
+			clientID = userID
+		}
+
+		refillRate := rule.Window / time.Duration(rule.MaxRequests)
+		limiter := store.GetLimiter(route+"|"+clientID, rule.MaxRequests, refillRate)
+
+		if !limiter.Allow() {
+			helper.ErrorResponse(c, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", "Too many requests. Please try again later.")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CostRateLimitMiddleware creates a rate limiting middleware where each request costs a variable number of tokens.
+//
+// The costFunc decides how many tokens a request consumes (values below 1 count as 1).
+// Requests that cannot be afforded are rejected with 429 and a Retry-After header.
+// A cost larger than maxTokens can never succeed and is always rejected.
+// Pass trustedProxies to resolve the IP via helper.RealClientIP instead of c.ClientIP().
+//
+// Example:
+//
+//	cost := func(c *gin.Context) int {
+//	    if strings.HasPrefix(c.FullPath(), "/export") {
+//	        return 50
+//	    }
+//	    return 1
+//	}
+//	r.Use(middleware.CostRateLimitMiddleware(1000, time.Minute, cost))
+//
+//	// Behind a load balancer in 10.0.0.0/8
+//	r.Use(middleware.CostRateLimitMiddleware(1000, time.Minute, cost, "10.0.0.0/8"))
+func CostRateLimitMiddleware(maxTokens int, window time.Duration, costFunc func(*gin.Context) int, trustedProxies ...string) gin.HandlerFunc {
+	store := NewRateLimiterStore()
+	refillRate := window / time.Duration(maxTokens)
+
+	return func(c *gin.Context) {
+		clientID := helper.RealClientIP(c, trustedProxies)
+		if userID, ok := helper.GetUserIDStringFromContext(c); ok {
+			clientID = userID
+		}
+
+		cost := costFunc(c)
+		if cost < 1 {
+			cost = 1
+		}
+
+		limiter := store.GetLimiter(clientID, maxTokens, refillRate)
+
+		if cost > maxTokens || !limiter.AllowN(cost) {
+			retryAfter := window
+			if cost <= maxTokens {
+				retryAfter = limiter.retryAfter(cost)
+			}
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			helper.ErrorResponse(c, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", "Too many requests. Please try again later.")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
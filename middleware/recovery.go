@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"runtime/debug"
@@ -78,6 +79,19 @@ func RecoveryWithCustomHandlerMiddleware(handler func(*gin.Context, interface{})
 // RecoveryWithNotificationMiddleware recovers and sends notification
 // You can integrate with services like Sentry, Slack, etc.
 func RecoveryWithNotificationMiddleware(logger *logrus.Logger, notifyFunc func(requestID string, err interface{}, stack string)) gin.HandlerFunc {
+	var notifyCtxFunc func(ctx context.Context, err interface{}, stack string)
+	if notifyFunc != nil {
+		notifyCtxFunc = func(ctx context.Context, err interface{}, stack string) {
+			notifyFunc(helper.RequestIDFromContext(ctx), err, stack)
+		}
+	}
+	return RecoveryWithNotificationContextMiddleware(logger, notifyCtxFunc)
+}
+
+// RecoveryWithNotificationContextMiddleware recovers and sends notification with a detached context
+// The context comes from helper.DetachContext: it carries request_id and user_id
+// but is not cancelled when the response is written, so the notifier can finish its work.
+func RecoveryWithNotificationContextMiddleware(logger *logrus.Logger, notifyFunc func(ctx context.Context, err interface{}, stack string)) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -96,7 +110,8 @@ func RecoveryWithNotificationMiddleware(logger *logrus.Logger, notifyFunc func(r
 
 				// Send notification
 				if notifyFunc != nil {
-					go notifyFunc(requestID, err, stack)
+					ctx := helper.DetachContext(c)
+					helper.Go(func() { notifyFunc(ctx, err, stack) })
 				}
 
 				helper.ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "An unexpected error occurred")
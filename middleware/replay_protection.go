@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// NonceStore remembers nonces that have already been used.
+//
+// Implement it on top of Redis to share seen nonces across instances, e.g. with
+// SET nonce 1 NX PX ttl, returning true when the key was set.
+type NonceStore interface {
+	// CheckAndStore records nonce for ttl and returns true if it had not been seen before.
+	CheckAndStore(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+// MemoryNonceStore is an in-memory NonceStore for single-instance deployments.
+//
+// Expired nonces are cleaned up every minute.
+type MemoryNonceStore struct {
+	nonces map[string]time.Time
+	mu     sync.Mutex
+}
+
+// NewMemoryNonceStore creates a new in-memory nonce store with automatic cleanup.
+func NewMemoryNonceStore() *MemoryNonceStore {
+	store := &MemoryNonceStore{
+		nonces: make(map[string]time.Time),
+	}
+	// Start cleanup goroutine to remove expired nonces
+	go store.cleanup()
+	return store
+}
+
+// cleanup removes expired nonces every minute
+func (s *MemoryNonceStore) cleanup() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		now := time.Now()
+		for nonce, expiresAt := range s.nonces {
+			if now.After(expiresAt) {
+				delete(s.nonces, nonce)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// CheckAndStore records nonce for ttl and returns true if it had not been seen before.
+func (s *MemoryNonceStore) CheckAndStore(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if expiresAt, exists := s.nonces[nonce]; exists && now.Before(expiresAt) {
+		return false, nil
+	}
+	s.nonces[nonce] = now.Add(ttl)
+	return true, nil
+}
+
+// ReplayConfig configures ReplayProtectionMiddleware.
+type ReplayConfig struct {
+	TimestampHeader string        // Header with Unix seconds or RFC3339 timestamp (default X-Timestamp)
+	NonceHeader     string        // Header with a unique request nonce (default X-Nonce)
+	MaxSkew         time.Duration // Allowed clock difference in either direction (default 5 minutes)
+	NonceTTL        time.Duration // How long nonces are remembered (default 2 * MaxSkew)
+	Store           NonceStore    // Seen-nonce store (default NewMemoryNonceStore())
+}
+
+// ReplayProtectionMiddleware rejects stale or replayed requests.
+//
+// Requires a timestamp within MaxSkew of the server time and a nonce that has not been
+// seen within NonceTTL. Keep NonceTTL at least 2 * MaxSkew so every accepted timestamp
+// is covered by the nonce check. Error codes:
+//   - MISSING_TIMESTAMP / INVALID_TIMESTAMP / STALE_TIMESTAMP (401)
+//   - MISSING_NONCE / REPLAYED_NONCE (401)
+//   - NONCE_STORE_UNAVAILABLE (503)
+//
+// Unsigned headers can be changed at will, so register it after
+// HMACSignatureMiddlewareWithConfig with both TimestampHeader and NonceHeader signed:
+// a replay then cannot pick a fresh nonce, and only authenticated requests use up
+// nonce store entries.
+//
+// Example:
+//
+//	webhook.Use(
+//	    middleware.HMACSignatureMiddlewareWithConfig(middleware.HMACConfig{
+//	        Secret:          "partner-secret",
+//	        TimestampHeader: "X-Timestamp",
+//	        NonceHeader:     "X-Nonce",
+//	    }),
+//	    middleware.ReplayProtectionMiddleware(middleware.ReplayConfig{MaxSkew: 2 * time.Minute}),
+//	)
+func ReplayProtectionMiddleware(cfg ReplayConfig) gin.HandlerFunc {
+	if cfg.TimestampHeader == "" {
+		cfg.TimestampHeader = "X-Timestamp"
+	}
+	if cfg.NonceHeader == "" {
+		cfg.NonceHeader = "X-Nonce"
+	}
+	if cfg.MaxSkew <= 0 {
+		cfg.MaxSkew = 5 * time.Minute
+	}
+	if cfg.NonceTTL <= 0 {
+		cfg.NonceTTL = 2 * cfg.MaxSkew
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryNonceStore()
+	}
+
+	return func(c *gin.Context) {
+		tsHeader := c.GetHeader(cfg.TimestampHeader)
+		if tsHeader == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_TIMESTAMP", cfg.TimestampHeader+" header is required")
+			c.Abort()
+			return
+		}
+
+		ts, ok := parseRequestTimestamp(tsHeader)
+		if !ok {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TIMESTAMP", cfg.TimestampHeader+" must be Unix seconds or RFC3339")
+			c.Abort()
+			return
+		}
+
+		skew := time.Since(ts)
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > cfg.MaxSkew {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "STALE_TIMESTAMP", "Request timestamp is outside the allowed window")
+			c.Abort()
+			return
+		}
+
+		nonce := c.GetHeader(cfg.NonceHeader)
+		if nonce == "" {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "MISSING_NONCE", cfg.NonceHeader+" header is required")
+			c.Abort()
+			return
+		}
+
+		fresh, err := cfg.Store.CheckAndStore(c.Request.Context(), nonce, cfg.NonceTTL)
+		if err != nil {
+			helper.ErrorResponse(c, http.StatusServiceUnavailable, "NONCE_STORE_UNAVAILABLE", "Unable to verify request nonce")
+			c.Abort()
+			return
+		}
+		if !fresh {
+			helper.ErrorResponse(c, http.StatusUnauthorized, "REPLAYED_NONCE", "Request nonce has already been used")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// parseRequestTimestamp parses Unix seconds or RFC3339
+func parseRequestTimestamp(value string) (time.Time, bool) {
+	if sec, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(sec, 0), true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"github.com/AECInfraconnect/go-module-helper/helper"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -10,7 +11,7 @@ const (
 	RequestIDHeader = "X-Request-ID"
 
 	// RequestIDKey is the Gin context key for storing request ID.
-	RequestIDKey = "request_id"
+	RequestIDKey = helper.ContextKeyRequestID
 )
 
 // RequestIDMiddleware generates or extracts request IDs for distributed tracing.
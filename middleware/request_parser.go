@@ -4,13 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
-	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/AECInfraconnect/go-module-helper/helper"
 	"github.com/gin-gonic/gin"
 	"github.com/joncalhoun/qson"
 )
@@ -20,6 +22,18 @@ const (
 	MiddleWareJWT = "jwt"
 )
 
+var (
+	// ErrUnsupportedContentType is returned by Form for bodies it cannot parse.
+	ErrUnsupportedContentType = errors.New("unsupported Content-Type")
+
+	// ErrJSONBodyNotObject is returned by Form for JSON bodies that are not an object (e.g. "hello" or [1,2]).
+	ErrJSONBodyNotObject = errors.New("JSON body must be an object")
+
+	// MaxRawBodySize limits the binary bodies Form reads into context and is the default
+	// body limit of HMACSignatureMiddleware and JSONSchemaMiddleware (default 32 MiB).
+	MaxRawBodySize int64 = 32 << 20
+)
+
 // GoMiddlewareInf defines the interface for request parsing middlewares.
 type GoMiddlewareInf interface {
 	InitContextIfNotExists() gin.HandlerFunc
@@ -37,15 +51,26 @@ type GoMiddleware struct {
 // Supports application/json, multipart/form-data, and application/x-www-form-urlencoded.
 // Parsed data is stored in context with key "params".
 // Uploaded files are stored with keys "files" or "part_file".
+// Binary bodies are kept raw (see Form); a body over MaxRawBodySize responds 413.
 //
 // Example:
 //
 //	m := middleware.InitMiddleware("jwt-secret")
 //	r.Use(m.InputForm())
+//
+//	// Raw upload: PUT /files/report.pdf with Content-Type: application/octet-stream
+//	r.PUT("/files/:name", func(c *gin.Context) {
+//	    body, _ := helper.ReadAndRestoreBody(c, middleware.MaxRawBodySize)
+//	    saveFile(c.Param("name"), body)
+//	})
 func (m *GoMiddleware) InputForm() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if err := Form(c); err != nil {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			status := http.StatusBadRequest
+			if errors.Is(err, helper.ErrBodyTooLarge) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			c.AbortWithStatusJSON(status, gin.H{
 				"message": err.Error(),
 			})
 			return
@@ -94,12 +119,22 @@ func InitMiddleware(key string) GoMiddlewareInf {
 //
 // Supports JSON, multipart form data, and URL-encoded forms.
 // Stores parsed parameters in context with key "params".
+// A JSON request always gets a non-nil map, empty for an empty, {} or null body;
+// a JSON body that is not an object (e.g. "hello") returns ErrJSONBodyNotObject.
+// JSON and URL-encoded bodies are read with helper.ReadAndRestoreBody, so the raw body
+// stays readable for later middlewares and handlers (multipart bodies are not cached).
+// Binary bodies (application/octet-stream, application/pdf, application/zip, image/*,
+// audio/* and video/*) are not parsed: they get empty params and the raw bytes are
+// cached under helper.ContextKeyRawBody. Bodies over MaxRawBodySize return an error
+// wrapping helper.ErrBodyTooLarge.
+// POST, PUT and PATCH requests with a body in any other Content-Type return
+// an error wrapping ErrUnsupportedContentType. Requests without a body are fine.
 func Form(c *gin.Context) error {
 	var data = map[string]any{}
 	reqMethod := c.Request.Method
 	Header := c.Request.Header
 
-	if reqMethod == http.MethodPost || reqMethod == http.MethodPut || reqMethod == http.MethodDelete {
+	if reqMethod == http.MethodPost || reqMethod == http.MethodPut || reqMethod == http.MethodPatch || reqMethod == http.MethodDelete {
 		contentType := Header.Get("Content-Type")
 		if strings.Contains(contentType, "multipart/form-data") {
 			form, err := c.MultipartForm()
@@ -108,6 +143,7 @@ func Form(c *gin.Context) error {
 			}
 			bu, _ := qson.ToJSON(url.Values(form.Value).Encode())
 			json.Unmarshal(bu, &data)
+			data = normalizeIndexedArrays(data).(map[string]any)
 
 			data, err = parseOnKeyData(data)
 			if err != nil {
@@ -122,58 +158,186 @@ func Form(c *gin.Context) error {
 				c.Set("part_file", val)
 			}
 		} else if strings.Contains(contentType, "application/json") {
-			var err error
-			if err := c.ShouldBindJSON(&data); err != nil && err != io.EOF {
+			body, err := helper.ReadAndRestoreBody(c, 0)
+			if err != nil {
+				return err
+			}
+			if data, err = decodeJSONBody(body); err != nil {
 				return err
 			}
 			data, err = parseOnKeyData(data)
 			if err != nil {
 				return err
 			}
+			// A JSON request always gets params, even for an empty body or {}
+			c.Set(helper.ContextKeyParams, data)
 
 		} else if strings.Contains(contentType, "application/x-www-form-urlencoded") {
-			postForm := c.Request.PostForm
 			var err error
+			if reqMethod != http.MethodDelete && c.Request.PostForm == nil {
+				if _, err := helper.ReadAndRestoreBody(c, 0); err != nil {
+					return err
+				}
+				if err := c.Request.ParseForm(); err != nil {
+					return err
+				}
+				// ParseForm consumed the body; restore it from the cache
+				helper.ReadAndRestoreBody(c, 0)
+			}
+			postForm := c.Request.PostForm
 			if reqMethod == http.MethodDelete {
-				buf := bytes.Buffer{}
-				io.Copy(&buf, c.Request.Body)
-				postForm, _ = url.ParseQuery(buf.String())
+				body, err := helper.ReadAndRestoreBody(c, 0)
+				if err != nil {
+					return err
+				}
+				postForm, _ = url.ParseQuery(string(body))
 			}
 			if len(postForm) > 0 {
 				bu, _ := qson.ToJSON(postForm.Encode())
 				json.Unmarshal(bu, &data)
+				data = normalizeIndexedArrays(data).(map[string]any)
 			}
 			data, err = parseOnKeyData(data)
 			if err != nil {
 				return err
 			}
+		} else if isBinaryContentType(contentType) {
+			if _, err := helper.ReadAndRestoreBody(c, MaxRawBodySize); err != nil {
+				return err
+			}
+			// The raw body is in context; handlers still get (empty) params
+			c.Set(helper.ContextKeyParams, data)
+
+		} else if reqMethod != http.MethodDelete && hasRequestBody(c.Request) {
+			return fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
 		}
 	}
 
 	if len(data) > 0 {
-		c.Set("params", data)
+		c.Set(helper.ContextKeyParams, data)
 	}
 	return nil
 }
 
+// decodeJSONBody decodes a JSON object body. An empty or null body gives an empty map
+func decodeJSONBody(body []byte) (map[string]any, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || bytes.Equal(body, []byte("null")) {
+		return map[string]any{}, nil
+	}
+	if body[0] != '{' {
+		if !json.Valid(body) {
+			var data map[string]any
+			return nil, json.Unmarshal(body, &data)
+		}
+		return nil, ErrJSONBodyNotObject
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// isBinaryContentType reports whether Form keeps the body raw instead of parsing it
+func isBinaryContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(strings.ToLower(contentType), ";")
+	mediaType = strings.TrimSpace(mediaType)
+	switch mediaType {
+	case "application/octet-stream", "application/pdf", "application/zip":
+		return true
+	}
+	return strings.HasPrefix(mediaType, "image/") || strings.HasPrefix(mediaType, "audio/") || strings.HasPrefix(mediaType, "video/")
+}
+
+// hasRequestBody reports whether the request carries a body
+func hasRequestBody(r *http.Request) bool {
+	return r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
+
+// parseOnKeyData unwraps a {"data": ...} envelope, the only key of the body:
+//   - {"data": {...}} gives the inner object
+//   - {"data": "{...}"} gives the object decoded from the JSON string; an empty or
+//     "null" string gives an empty map and any other JSON value returns ErrJSONBodyNotObject
+//   - {"data": null} gives an empty map
+//   - any other value ({"data": 5}, {"data": [1,2]}) is a regular field and is kept as is
 func parseOnKeyData(data map[string]any) (map[string]any, error) {
-	if len(data) == 1 {
-		/*
-			support on data from json format
-		*/
-		if v, ok := data["data"]; ok {
-			valueType := reflect.ValueOf(v).Kind()
-			switch valueType {
-			case reflect.Map:
-				data = v.(map[string]any)
-			case reflect.String:
-				data = map[string]any{}
-				if err := json.Unmarshal([]byte(v.(string)), &data); err != nil {
-					return data, err
+	if len(data) != 1 {
+		return data, nil
+	}
+
+	v, ok := data["data"]
+	if !ok {
+		return data, nil
+	}
+
+	switch payload := v.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		return payload, nil
+	case string:
+		decoded, err := decodeJSONBody([]byte(payload))
+		if err != nil {
+			return map[string]any{}, err
+		}
+		return decoded, nil
+	default:
+		return data, nil
+	}
+}
+
+// normalizeIndexedArrays converts maps produced from indexed bracket keys into slices.
+//
+// qson turns "items[0][name]=x&items[1][name]=y" into {"items": {"0": {...}, "1": {...}}}.
+// Any nested map whose keys are all non-negative integers becomes a slice ordered by index,
+// so the example yields {"items": [{"name": "x"}, {"name": "y"}]}. Sparse indexes are compacted.
+// The top-level map is never converted.
+func normalizeIndexedArrays(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			val[k] = normalizeIndexedArrays(item)
+			if m, ok := val[k].(map[string]any); ok {
+				if arr, ok := indexedMapToSlice(m); ok {
+					val[k] = arr
+				}
+			}
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = normalizeIndexedArrays(item)
+			if m, ok := val[i].(map[string]any); ok {
+				if arr, ok := indexedMapToSlice(m); ok {
+					val[i] = arr
 				}
 			}
 		}
+		return val
+	}
+	return v
+}
+
+// indexedMapToSlice returns the map values ordered by their integer keys
+func indexedMapToSlice(m map[string]any) ([]interface{}, bool) {
+	if len(m) == 0 {
+		return nil, false
+	}
+	indexes := make([]int, 0, len(m))
+	for k := range m {
+		idx, err := strconv.Atoi(k)
+		if err != nil || idx < 0 {
+			return nil, false
+		}
+		indexes = append(indexes, idx)
 	}
+	sort.Ints(indexes)
 
-	return data, nil
+	arr := make([]interface{}, len(indexes))
+	for i, idx := range indexes {
+		arr[i] = m[strconv.Itoa(idx)]
+	}
+	return arr, true
 }
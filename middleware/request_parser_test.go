@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// serveForm sends a request through InputForm and returns the response and the parsed params
+func serveForm(t *testing.T, method, contentType string, body []byte) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	var params map[string]any
+	r := gin.New()
+	r.Use(InitMiddleware("secret").InputForm())
+	r.Handle(method, "/", func(c *gin.Context) {
+		if v, ok := c.Get(helper.ContextKeyParams); ok {
+			params = v.(map[string]any)
+		}
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(method, "/", bytes.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w, params
+}
+
+func TestFormNestedIndexedKeys(t *testing.T) {
+	values := url.Values{
+		"items[0][name]":       {"x"},
+		"items[0][tags][0]":    {"a"},
+		"items[0][tags][1]":    {"b"},
+		"items[1][name]":       {"y"},
+		"user[address][city]":  {"Bangkok"},
+		"user[phones][0]":      {"0812345678"},
+		"matrix[0][0]":         {"a"},
+		"matrix[0][1]":         {"b"},
+		"matrix[1][0]":         {"c"},
+		"sparse[2][value]":     {"second"},
+		"sparse[0][value]":     {"first"},
+		"labels[en][greeting]": {"hello"},
+	}
+	want := map[string]any{
+		"items": []interface{}{
+			map[string]any{"name": "x", "tags": []interface{}{"a", "b"}},
+			map[string]any{"name": "y"},
+		},
+		"user": map[string]any{
+			"address": map[string]any{"city": "Bangkok"},
+			"phones":  []interface{}{"0812345678"},
+		},
+		"matrix": []interface{}{
+			[]interface{}{"a", "b"},
+			[]interface{}{"c"},
+		},
+		"sparse": []interface{}{
+			map[string]any{"value": "first"},
+			map[string]any{"value": "second"},
+		},
+		"labels": map[string]any{"en": map[string]any{"greeting": "hello"}},
+	}
+
+	t.Run("urlencoded", func(t *testing.T) {
+		w, params := serveForm(t, http.MethodPost, "application/x-www-form-urlencoded", []byte(values.Encode()))
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body %s", w.Code, w.Body.String())
+		}
+		if !reflect.DeepEqual(params, want) {
+			t.Errorf("params = %#v\nwant %#v", params, want)
+		}
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		for key, vals := range values {
+			for _, v := range vals {
+				mw.WriteField(key, v)
+			}
+		}
+		mw.Close()
+
+		w, params := serveForm(t, http.MethodPost, mw.FormDataContentType(), body.Bytes())
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, body %s", w.Code, w.Body.String())
+		}
+		if !reflect.DeepEqual(params, want) {
+			t.Errorf("params = %#v\nwant %#v", params, want)
+		}
+	})
+}
+
+func TestFormUnsupportedContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		wantStatus  int
+	}{
+		{"xml body", http.MethodPost, "text/xml", "<user><name>x</name></user>", http.StatusBadRequest},
+		{"xml put", http.MethodPut, "application/xml", "<user/>", http.StatusBadRequest},
+		{"no content type", http.MethodPatch, "", "name=x", http.StatusBadRequest},
+		{"xml without body", http.MethodPost, "text/xml", "", http.StatusOK},
+		{"get ignores content type", http.MethodGet, "text/xml", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := serveForm(t, tt.method, tt.contentType, []byte(tt.body))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusBadRequest && !strings.Contains(w.Body.String(), ErrUnsupportedContentType.Error()) {
+				t.Errorf("body %s does not mention %q", w.Body.String(), ErrUnsupportedContentType)
+			}
+		})
+	}
+}
+
+func TestFormJSONBodies(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantParams map[string]any
+		wantError  string
+	}{
+		{"empty body", "", http.StatusOK, map[string]any{}, ""},
+		{"whitespace", "  \n", http.StatusOK, map[string]any{}, ""},
+		{"empty object", "{}", http.StatusOK, map[string]any{}, ""},
+		{"null", "null", http.StatusOK, map[string]any{}, ""},
+		{"object", `{"name":"x"}`, http.StatusOK, map[string]any{"name": "x"}, ""},
+		{"string", `"hello"`, http.StatusBadRequest, nil, ErrJSONBodyNotObject.Error()},
+		{"number", "5", http.StatusBadRequest, nil, ErrJSONBodyNotObject.Error()},
+		{"array", "[1,2]", http.StatusBadRequest, nil, ErrJSONBodyNotObject.Error()},
+		{"malformed", `{"name":`, http.StatusBadRequest, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, params := serveForm(t, http.MethodPost, "application/json", []byte(tt.body))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantError != "" && !strings.Contains(w.Body.String(), tt.wantError) {
+				t.Errorf("body %s does not mention %q", w.Body.String(), tt.wantError)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if params == nil {
+				t.Fatal("params must be a non-nil map")
+			}
+			if !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("params = %#v, want %#v", params, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestParseOnKeyData(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    map[string]any
+		wantErr bool
+	}{
+		{"null", `{"data":null}`, map[string]any{}, false},
+		{"number", `{"data":5}`, map[string]any{"data": float64(5)}, false},
+		{"array", `{"data":[1,2]}`, map[string]any{"data": []interface{}{float64(1), float64(2)}}, false},
+		{"object", `{"data":{"name":"x"}}`, map[string]any{"name": "x"}, false},
+		{"json string", `{"data":"{\"name\":\"x\"}"}`, map[string]any{"name": "x"}, false},
+		{"empty string", `{"data":""}`, map[string]any{}, false},
+		{"null string", `{"data":"null"}`, map[string]any{}, false},
+		{"non-object string", `{"data":"[1,2]"}`, nil, true},
+		{"other keys", `{"data":5,"page":1}`, map[string]any{"data": float64(5), "page": float64(1)}, false},
+		{"single other key", `{"name":"x"}`, map[string]any{"name": "x"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data map[string]any
+			if err := json.Unmarshal([]byte(tt.body), &data); err != nil {
+				t.Fatal(err)
+			}
+			got, err := parseOnKeyData(data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrJSONBodyNotObject) {
+					t.Errorf("error = %v, want ErrJSONBodyNotObject", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseOnKeyData(%s) = %#v, want %#v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormRawBinaryBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	data := append([]byte{0x00, 0xff, 0x89, 'P', 'N', 'G'}, bytes.Repeat([]byte{0xfe}, 1024)...)
+
+	for _, contentType := range []string{"application/octet-stream", "image/png", "application/pdf; charset=binary"} {
+		t.Run(contentType, func(t *testing.T) {
+			var raw, body []byte
+			var params interface{}
+			r := gin.New()
+			r.Use(InitMiddleware("secret").InputForm())
+			r.PUT("/files/:name", func(c *gin.Context) {
+				if v, ok := c.Get(helper.ContextKeyRawBody); ok {
+					raw, _ = v.([]byte)
+				}
+				body, _ = io.ReadAll(c.Request.Body)
+				params, _ = c.Get(helper.ContextKeyParams)
+				c.Status(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/files/report.bin", bytes.NewReader(data))
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != http.StatusNoContent {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body.String())
+			}
+			if !bytes.Equal(raw, data) {
+				t.Errorf("raw body in context has %d bytes, want %d", len(raw), len(data))
+			}
+			if !bytes.Equal(body, data) {
+				t.Errorf("request body has %d bytes, want it restored with %d", len(body), len(data))
+			}
+			if p, ok := params.(map[string]any); !ok || len(p) != 0 {
+				t.Errorf("params = %#v, want an empty map", params)
+			}
+		})
+	}
+}
+
+func TestFormRawBodyTooLarge(t *testing.T) {
+	saved := MaxRawBodySize
+	MaxRawBodySize = 16
+	defer func() { MaxRawBodySize = saved }()
+
+	w, _ := serveForm(t, http.MethodPut, "application/octet-stream", bytes.Repeat([]byte("x"), 17))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want 413", w.Code)
+	}
+	if w, _ := serveForm(t, http.MethodPut, "application/octet-stream", bytes.Repeat([]byte("x"), 16)); w.Code != http.StatusOK {
+		t.Errorf("status at the limit = %d, want 200", w.Code)
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// ResponseTimingMiddleware stores the request start time for response timing metadata.
+//
+// When helper.IncludeResponseTiming is true, helper.SuccessResponse, ErrorResponse and the
+// other response helpers add "took_ms" (measured right before serialization) and
+// "request_id" to the meta block. Apply it first, after RequestIDMiddleware, so the
+// timing covers the whole chain.
+//
+// Example:
+//
+//	helper.IncludeResponseTiming = os.Getenv("APP_ENV") != "production"
+//	r.Use(middleware.RequestIDMiddleware())
+//	r.Use(middleware.ResponseTimingMiddleware())
+//	// {"success":true,"data":{...},"meta":{"took_ms":12.345,"request_id":"550e8400-..."}}
+func ResponseTimingMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(helper.ContextKeyRequestStart, time.Now())
+		c.Next()
+	}
+}
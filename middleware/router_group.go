@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// RouterGroupBuilder composes a gin route group with a common middleware stack.
+//
+// Middlewares run in the order the With* methods are called.
+type RouterGroupBuilder struct {
+	router   gin.IRouter
+	prefix   string
+	handlers []gin.HandlerFunc
+}
+
+// NewRouterGroup starts a route group builder for prefix on r (a *gin.Engine or another group).
+//
+// Example:
+//
+//	api := middleware.NewRouterGroup(r, "/api/v1").
+//	    WithLogging(logger).
+//	    WithJWT(jwtSecret).
+//	    WithRateLimit(100, time.Minute).
+//	    Group()
+//	api.GET("/profile", getProfile)
+func NewRouterGroup(r gin.IRouter, prefix string) *RouterGroupBuilder {
+	return &RouterGroupBuilder{router: r, prefix: prefix}
+}
+
+// WithJWT adds JWTAuthMiddleware.
+func (b *RouterGroupBuilder) WithJWT(jwtSecret string) *RouterGroupBuilder {
+	return b.Use(JWTAuthMiddleware(jwtSecret))
+}
+
+// WithRateLimit adds RateLimitMiddleware (per user when authenticated, per IP otherwise).
+// Add it after WithJWT to limit per user.
+func (b *RouterGroupBuilder) WithRateLimit(maxRequests int, window time.Duration, trustedProxies ...string) *RouterGroupBuilder {
+	return b.Use(RateLimitMiddleware(maxRequests, window, trustedProxies...))
+}
+
+// WithLogging adds LoggerMiddleware.
+func (b *RouterGroupBuilder) WithLogging(logger *logrus.Logger) *RouterGroupBuilder {
+	return b.Use(LoggerMiddleware(logger))
+}
+
+// Use adds any other middlewares to the stack.
+func (b *RouterGroupBuilder) Use(handlers ...gin.HandlerFunc) *RouterGroupBuilder {
+	b.handlers = append(b.handlers, handlers...)
+	return b
+}
+
+// Group creates the configured *gin.RouterGroup.
+func (b *RouterGroupBuilder) Group() *gin.RouterGroup {
+	return b.router.Group(b.prefix, b.handlers...)
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// Config centralizes the settings shared by the middlewares of a service.
+// Pass it to NewStack instead of handing secrets to each constructor separately.
+type Config struct {
+	JWTSecret string          // Secret for the JWT middlewares
+	APIKey    string          // Key for the API key middlewares
+	CORS      CORSConfig      // CORS settings (default: any origin)
+	RateLimit RateLimitConfig // Default rate limit
+	Logger    *logrus.Logger  // Logger for logging and recovery (default logrus.StandardLogger())
+}
+
+// RateLimitConfig holds the default rate limit of a Stack.
+type RateLimitConfig struct {
+	MaxRequests    int           // Requests per window (default 100)
+	Window         time.Duration // Window length (default 1 minute)
+	TrustedProxies []string      // Proxies trusted for the client IP (see RateLimitMiddleware)
+}
+
+// Stack provides the middlewares of this package pre-configured from a Config.
+// Each method returns a new handler, so rate limiter state is per call.
+type Stack struct {
+	cfg   Config
+	forms GoMiddlewareInf
+}
+
+// NewStack creates a Stack from cfg, filling in defaults.
+// It panics when cfg.CORS allows credentials without an explicit origin list
+// (see CORSMiddlewareWithConfig), so the mistake surfaces at startup.
+// InitMiddleware remains available for the request parsing middlewares alone.
+//
+// Example:
+//
+//	stack := middleware.NewStack(middleware.Config{
+//	    JWTSecret: os.Getenv("JWT_SECRET"),
+//	    APIKey:    os.Getenv("API_KEY"),
+//	    CORS:      middleware.CORSConfig{AllowOrigins: []string{"https://app.example.com"}},
+//	    RateLimit: middleware.RateLimitConfig{MaxRequests: 300, Window: time.Minute},
+//	    Logger:    logger,
+//	})
+//	r.Use(stack.Defaults()...)
+//
+//	api := r.Group("/api", stack.JWTAuth(), stack.RateLimit())
+//	internal := r.Group("/internal", stack.APIKeyAuth())
+func NewStack(cfg Config) *Stack {
+	if err := cfg.CORS.validate(); err != nil {
+		panic(err)
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = logrus.StandardLogger()
+	}
+	if cfg.RateLimit.MaxRequests <= 0 {
+		cfg.RateLimit.MaxRequests = 100
+	}
+	if cfg.RateLimit.Window <= 0 {
+		cfg.RateLimit.Window = time.Minute
+	}
+	return &Stack{cfg: cfg, forms: InitMiddleware(cfg.JWTSecret)}
+}
+
+// Defaults returns the recommended global chain: Recovery, RequestID, Logger and CORS.
+func (s *Stack) Defaults() []gin.HandlerFunc {
+	return []gin.HandlerFunc{s.Recovery(), s.RequestID(), s.Logger(), s.CORS()}
+}
+
+// Recovery returns RecoveryMiddleware with the configured logger.
+func (s *Stack) Recovery() gin.HandlerFunc {
+	return RecoveryMiddleware(s.cfg.Logger)
+}
+
+// RequestID returns RequestIDMiddleware.
+func (s *Stack) RequestID() gin.HandlerFunc {
+	return RequestIDMiddleware()
+}
+
+// Logger returns LoggerMiddleware with the configured logger.
+func (s *Stack) Logger() gin.HandlerFunc {
+	return LoggerMiddleware(s.cfg.Logger)
+}
+
+// CORS returns CORSMiddlewareWithConfig with the configured CORS settings.
+func (s *Stack) CORS() gin.HandlerFunc {
+	return CORSMiddlewareWithConfig(s.cfg.CORS)
+}
+
+// JWTAuth returns JWTAuthMiddleware with the configured secret.
+func (s *Stack) JWTAuth() gin.HandlerFunc {
+	return JWTAuthMiddleware(s.cfg.JWTSecret)
+}
+
+// OptionalJWTAuth returns OptionalJWTAuthMiddleware with the configured secret.
+func (s *Stack) OptionalJWTAuth() gin.HandlerFunc {
+	return OptionalJWTAuthMiddleware(s.cfg.JWTSecret)
+}
+
+// APIKeyAuth returns APIKeyAuthMiddleware with the configured key.
+func (s *Stack) APIKeyAuth() gin.HandlerFunc {
+	return APIKeyAuthMiddleware(s.cfg.APIKey)
+}
+
+// APIKeyOrJWTAuth returns APIKeyOrJWTAuthMiddleware with the configured key and secret.
+func (s *Stack) APIKeyOrJWTAuth() gin.HandlerFunc {
+	return APIKeyOrJWTAuthMiddleware(s.cfg.APIKey, s.cfg.JWTSecret)
+}
+
+// RateLimit returns RateLimitMiddleware with the configured default limit.
+func (s *Stack) RateLimit() gin.HandlerFunc {
+	return RateLimitMiddleware(s.cfg.RateLimit.MaxRequests, s.cfg.RateLimit.Window, s.cfg.RateLimit.TrustedProxies...)
+}
+
+// InputForm returns the request parsing middleware (see GoMiddleware.InputForm).
+func (s *Stack) InputForm() gin.HandlerFunc {
+	return s.forms.InputForm()
+}
+
+// InitContextIfNotExists returns the context initialization middleware.
+func (s *Stack) InitContextIfNotExists() gin.HandlerFunc {
+	return s.forms.InitContextIfNotExists()
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TransactionMiddleware runs each request in a database transaction.
+//
+// The transaction is started with the request context and stored in context; handlers
+// get it with helper.GetTx and use it instead of db for all their writes. After the
+// handlers run it is committed if the response status is below 400 and no errors were
+// added with c.Error, and rolled back otherwise. A failed commit responds 500 if
+// nothing was written yet, and is added to c.Errors either way.
+//
+// On a panic the transaction is rolled back and the panic is re-raised, so register
+// RecoveryMiddleware before TransactionMiddleware to turn it into a 500. (Registered
+// after it, RecoveryMiddleware handles the panic first and the 500 status rolls back.)
+// Handlers write the response before the commit runs, so a commit failure after a
+// response was written cannot change its status.
+//
+// Example:
+//
+//	r.Use(middleware.RecoveryMiddleware(logger))
+//	orders := r.Group("/orders", middleware.TransactionMiddleware(db))
+//	orders.POST("", func(c *gin.Context) {
+//	    tx, _ := helper.GetTx(c)
+//	    if err := tx.Create(&order).Error; err != nil {
+//	        helper.ErrorResponse(c, 500, "INTERNAL_SERVER_ERROR", "Unable to create order")
+//	        return
+//	    }
+//	    if err := tx.Create(&order.Items).Error; err != nil {
+//	        helper.ErrorResponse(c, 500, "INTERNAL_SERVER_ERROR", "Unable to create items")
+//	        return // the order insert is rolled back
+//	    }
+//	    helper.SuccessResponse(c, 201, order)
+//	})
+func TransactionMiddleware(db *gorm.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tx := db.WithContext(c.Request.Context()).Begin()
+		if tx.Error != nil {
+			helper.ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "Unable to start transaction")
+			c.Abort()
+			return
+		}
+		c.Set(helper.ContextKeyTx, tx)
+
+		defer func() {
+			if r := recover(); r != nil {
+				tx.Rollback()
+				panic(r)
+			}
+		}()
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest || len(c.Errors) > 0 {
+			tx.Rollback()
+			return
+		}
+		if err := tx.Commit().Error; err != nil {
+			c.Error(err)
+			if !c.Writer.Written() {
+				helper.ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "Unable to commit transaction")
+			}
+		}
+	}
+}
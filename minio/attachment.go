@@ -0,0 +1,111 @@
+package minio
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// OriginalFilenameMetadataKey is the user metadata key holding the uploaded file name
+	// (x-amz-meta-original-filename), percent-encoded so non-ASCII names survive as headers
+	OriginalFilenameMetadataKey = "original-filename"
+)
+
+// UploadWithOriginalFilename uploads data under a generated object name and records the
+// user's file name as metadata, so ServeObjectAsAttachment can offer it on download.
+//
+// Parameters:
+//   - bucketName: Target bucket name
+//   - objectName: Destination object path (e.g. from GenerateObjectName)
+//   - reader: Data source
+//   - size: Total size of data in bytes
+//   - contentType: MIME type of the data
+//   - filename: Original file name, e.g. "ใบเสนอราคา.pdf"
+//
+// Example:
+//
+//	file, _ := c.FormFile("document")
+//	src, _ := file.Open()
+//	defer src.Close()
+//	err := client.UploadWithOriginalFilename("documents", objectName, src, file.Size, "application/pdf", file.Filename)
+func (c *Client) UploadWithOriginalFilename(bucketName string, objectName string, reader io.Reader, size int64, contentType string, filename string) error {
+	return c.UploadWithOriginalFilenameWithContext(c.baseContext(), bucketName, objectName, reader, size, contentType, filename)
+}
+
+// UploadWithOriginalFilenameWithContext uploads data with its original file name with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err := client.UploadWithOriginalFilenameWithContext(ctx, "documents", objectName, reader, size, "application/pdf", "report.pdf")
+func (c *Client) UploadWithOriginalFilenameWithContext(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, contentType string, filename string) error {
+	_, err := c.putObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: originalFilenameMetadata(filename),
+	})
+	return err
+}
+
+// ServeObjectAsAttachment streams an object as a download named after its original file name.
+// Content-Disposition carries the name recorded by UploadWithOriginalFilename (or
+// UploadMultipartFile), falling back to the last segment of the object name; non-ASCII
+// names such as Thai are sent RFC 5987 encoded in filename* with an ASCII
+// fallback. Range requests are honored as in ServeObjectWithRange.
+//
+// Errors from MinIO (e.g. object not found) are returned without writing a response.
+//
+// Parameters:
+//   - gc: Gin context of the request
+//   - bucketName: Bucket containing the object
+//   - objectName: Path to the object
+//
+// Example:
+//
+//	r.GET("/documents/:id/download", func(c *gin.Context) {
+//	    if err := client.ServeObjectAsAttachment(c, "documents", documentObject(c.Param("id"))); err != nil {
+//	        helper.ErrorResponse(c, 404, "NOT_FOUND", "Document not found")
+//	    }
+//	})
+func (c *Client) ServeObjectAsAttachment(gc *gin.Context, bucketName string, objectName string) error {
+	info, err := c.statObject(gc.Request.Context(), bucketName, objectName)
+	if err != nil {
+		return err
+	}
+
+	filename := OriginalFilename(info)
+	if filename == "" {
+		filename = path.Base(objectName)
+	}
+	return c.serveObject(gc, bucketName, info, map[string]string{
+		"Content-Disposition": helper.AttachmentDisposition(filename),
+	})
+}
+
+// OriginalFilename returns the file name recorded at upload, or "" if there is none
+func OriginalFilename(info minio.ObjectInfo) string {
+	value := objectMetadata(info)[canonicalMetadataKey(OriginalFilenameMetadataKey)]
+	if value == "" {
+		return ""
+	}
+	if decoded, err := url.PathUnescape(value); err == nil {
+		return decoded
+	}
+	return value
+}
+
+// originalFilenameMetadata returns the user metadata recording filename, or nil when empty
+func originalFilenameMetadata(filename string) map[string]string {
+	filename = path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil
+	}
+	return map[string]string{OriginalFilenameMetadataKey: url.PathEscape(filename)}
+}
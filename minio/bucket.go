@@ -28,7 +28,7 @@ func (c *Client) CreateBucket(bucketName string, region string) error {
 	if region == "" {
 		region = MINIO_DEFAULT_REGION
 	}
-	if err := c.GetClient().MakeBucket(context.Background(), bucketName, minio.MakeBucketOptions{
+	if err := c.GetClient().MakeBucket(c.baseContext(), bucketName, minio.MakeBucketOptions{
 		Region: region,
 	}); err != nil {
 		return err
@@ -74,7 +74,7 @@ func (c *Client) CreateBucketWithContext(ctx context.Context, bucketName string,
 //	    fmt.Println("Bucket exists")
 //	}
 func (c *Client) ExistBucket(bucketName string) (bool, error) {
-	exists, err := c.GetClient().BucketExists(context.Background(), bucketName)
+	exists, err := c.GetClient().BucketExists(c.baseContext(), bucketName)
 	if err != nil {
 		return false, err
 	}
@@ -122,7 +122,7 @@ func (c *Client) SetBucketPublicPolicy(bucketName string) error {
 	}
 
 	policy := buf.String()
-	if err := c.GetClient().SetBucketPolicy(context.Background(), bucketName, policy); err != nil {
+	if err := c.GetClient().SetBucketPolicy(c.baseContext(), bucketName, policy); err != nil {
 		return err
 	}
 	log.Println("create bucket with policy success")
@@ -0,0 +1,87 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ErrUnknownBucketAlias is returned when a bucket alias was not registered.
+var ErrUnknownBucketAlias = errors.New("unknown bucket alias")
+
+// RegisterBucket maps an alias (e.g. "public", "docs", "temp") to a real bucket name.
+// Registering an existing alias replaces its bucket.
+//
+// Example:
+//
+//	client.RegisterBucket("public", "myapp-public-assets")
+//	client.RegisterBucket("docs", "myapp-private-docs")
+func (c *Client) RegisterBucket(alias string, bucketName string) {
+	if c.parent != nil {
+		c.parent.RegisterBucket(alias, bucketName)
+		return
+	}
+	c.bucketsMu.Lock()
+	defer c.bucketsMu.Unlock()
+	if c.buckets == nil {
+		c.buckets = make(map[string]string)
+	}
+	c.buckets[alias] = bucketName
+}
+
+// ResolveBucket returns the bucket name registered for alias.
+// Use it with any method that takes an explicit bucket name.
+//
+// Example:
+//
+//	bucket, err := client.ResolveBucket("docs")
+//	if err != nil {
+//	    return err
+//	}
+//	err = client.UploadMultipartFile(bucket, "contracts/2024.pdf", file)
+func (c *Client) ResolveBucket(alias string) (string, error) {
+	if c.parent != nil {
+		return c.parent.ResolveBucket(alias)
+	}
+	c.bucketsMu.RLock()
+	defer c.bucketsMu.RUnlock()
+	if bucketName, ok := c.buckets[alias]; ok {
+		return bucketName, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownBucketAlias, alias)
+}
+
+// UploadToAlias uploads data from an io.Reader to the bucket registered for alias.
+//
+// Parameters:
+//   - alias: Bucket alias registered with RegisterBucket
+//   - objectName: Destination object path
+//   - reader: Data source (io.Reader)
+//   - size: Total size of data in bytes (-1 if unknown)
+//   - contentType: MIME type (e.g., "image/png")
+//
+// Example:
+//
+//	err := client.UploadToAlias("public", "logos/logo.png", src, file.Size, "image/png")
+func (c *Client) UploadToAlias(alias string, objectName string, reader io.Reader, size int64, contentType string) error {
+	return c.UploadToAliasWithContext(c.baseContext(), alias, objectName, reader, size, contentType)
+}
+
+// UploadToAliasWithContext uploads data to the bucket registered for alias with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err := client.UploadToAliasWithContext(ctx, "temp", "exports/report.csv", reader, size, "text/csv")
+func (c *Client) UploadToAliasWithContext(ctx context.Context, alias string, objectName string, reader io.Reader, size int64, contentType string) error {
+	bucketName, err := c.ResolveBucket(alias)
+	if err != nil {
+		return err
+	}
+	_, err = c.putObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
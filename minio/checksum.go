@@ -0,0 +1,348 @@
+package minio
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// ChecksumMetadataKey is the user metadata key holding the SHA-256 digest (x-amz-meta-sha256)
+	ChecksumMetadataKey = "sha256"
+)
+
+// ErrNoChecksum is returned by VerifyObjectIntegrity for objects without a stored SHA-256 digest
+var ErrNoChecksum = errors.New("object has no sha256 metadata")
+
+// WithIntegrityHash returns a view of the client whose uploads store the SHA-256 digest of
+// the data as object metadata (x-amz-meta-sha256), for later checks with VerifyObjectIntegrity.
+// It applies to every upload method (UploadMultipartFile, UploadFileWithReader,
+// UploadFromFile, ...); the view otherwise shares everything with c, like WithDefaultContext.
+//
+// Seekable readers (files, multipart files, bytes.Reader) and local files are hashed
+// before the upload. Other readers are hashed while streaming and the digest is attached
+// afterwards with a server-side copy of the object onto itself (no data goes through the
+// client again; single copy requests are limited to 5GB objects). If that copy fails the
+// uploaded object is removed and the upload returns the error.
+//
+// Example:
+//
+//	storage := client.WithIntegrityHash()
+//	if err := storage.UploadMultipartFile("archive", "contracts/2024-001.pdf", file); err != nil {
+//	    log.Fatal(err)
+//	}
+//	valid, err := client.VerifyObjectIntegrity("archive", "contracts/2024-001.pdf")
+func (c *Client) WithIntegrityHash() *Client {
+	view := c.WithDefaultContext(c.defaultCtx)
+	view.integrityHash = true
+	return view
+}
+
+// UploadWithChecksum uploads data and returns its SHA-256 digest, which is also stored as
+// object metadata (x-amz-meta-sha256). The data is hashed as described for WithIntegrityHash.
+//
+// Parameters:
+//   - bucketName: Target bucket name
+//   - objectName: Destination object path
+//   - reader: Data source (io.Reader)
+//   - size: Total size of data in bytes (-1 if unknown)
+//   - contentType: MIME type (e.g., "application/pdf")
+//
+// Example:
+//
+//	file, _ := c.FormFile("upload")
+//	src, _ := file.Open()
+//	defer src.Close()
+//	digest, err := client.UploadWithChecksum("my-bucket", "uploads/file.pdf", src, file.Size, "application/pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// Save digest in DB to detect duplicate uploads
+func (c *Client) UploadWithChecksum(bucketName string, objectName string, reader io.Reader, size int64, contentType string) (string, error) {
+	return c.UploadWithChecksumWithContext(c.baseContext(), bucketName, objectName, reader, size, contentType)
+}
+
+// UploadWithChecksumWithContext uploads data and computes its SHA-256 digest with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	digest, err := client.UploadWithChecksumWithContext(ctx, "my-bucket", "file.txt", reader, size, "text/plain")
+func (c *Client) UploadWithChecksumWithContext(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, contentType string) (string, error) {
+	if err := checkObjectName(objectName); err != nil {
+		return "", err
+	}
+	_, digest, err := c.putObjectWithDigest(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType})
+	return digest, err
+}
+
+// putObjectWithDigest uploads data with its SHA-256 digest in user metadata and returns the digest
+func (c *Client) putObjectWithDigest(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, opts minio.PutObjectOptions) (minio.UploadInfo, string, error) {
+	hasher := sha256.New()
+
+	// Hash seekable readers up front so the digest goes out with the upload
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		if start, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			src := io.Reader(seeker)
+			if size >= 0 {
+				src = io.LimitReader(seeker, size)
+			}
+			if _, err := io.Copy(hasher, src); err != nil {
+				return minio.UploadInfo{}, "", err
+			}
+			if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+				return minio.UploadInfo{}, "", err
+			}
+			digest := hex.EncodeToString(hasher.Sum(nil))
+			opts.UserMetadata = withChecksum(opts.UserMetadata, digest)
+			info, err := c.putObjectRaw(ctx, bucketName, objectName, reader, size, opts)
+			return info, digest, err
+		}
+	}
+
+	info, err := c.putObjectRaw(ctx, bucketName, objectName, io.TeeReader(reader, hasher), size, opts)
+	if err != nil {
+		return info, "", err
+	}
+	digest := hex.EncodeToString(hasher.Sum(nil))
+
+	// The digest is only known now; attach it with a server-side copy, keeping the upload's headers
+	_, err = c.copyObject(ctx, minio.CopyDestOptions{
+		Bucket:          bucketName,
+		Object:          objectName,
+		UserMetadata:    withChecksum(putOptionsMetadata(opts), digest),
+		ReplaceMetadata: true,
+	}, minio.CopySrcOptions{
+		Bucket:    bucketName,
+		Object:    objectName,
+		MatchETag: info.ETag,
+	})
+	if err != nil {
+		// Don't leave an object without its digest behind; it would look like corruption later
+		if removeErr := c.removeObject(context.WithoutCancel(ctx), bucketName, objectName); removeErr != nil {
+			err = errors.Join(err, fmt.Errorf("remove object without digest: %w", removeErr))
+		}
+		return minio.UploadInfo{}, "", err
+	}
+	return info, digest, nil
+}
+
+// putOptionsMetadata collects the headers and user metadata of an upload for a metadata copy
+func putOptionsMetadata(opts minio.PutObjectOptions) map[string]string {
+	metadata := make(map[string]string, len(opts.UserMetadata)+5)
+	for key, value := range opts.UserMetadata {
+		metadata[key] = value
+	}
+	for key, value := range map[string]string{
+		"Content-Type":        opts.ContentType,
+		"Content-Encoding":    opts.ContentEncoding,
+		"Content-Disposition": opts.ContentDisposition,
+		"Content-Language":    opts.ContentLanguage,
+		"Cache-Control":       opts.CacheControl,
+	} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+// withChecksum returns a copy of metadata with the SHA-256 digest added
+func withChecksum(metadata map[string]string, digest string) map[string]string {
+	result := make(map[string]string, len(metadata)+1)
+	for key, value := range metadata {
+		result[key] = value
+	}
+	result[ChecksumMetadataKey] = digest
+	return result
+}
+
+// hasChecksum reports whether an upload already carries a SHA-256 digest
+func hasChecksum(opts minio.PutObjectOptions) bool {
+	for key := range opts.UserMetadata {
+		if canonicalMetadataKey(key) == canonicalMetadataKey(ChecksumMetadataKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileSHA256 returns the hex SHA-256 digest of a local file
+func fileSHA256(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// UploadDedup stores data under a name derived from its SHA-256 digest, skipping the upload
+// when an object with the same content already exists.
+// Object name format: {prefix}/{sha256hex}
+//
+// The data is spooled to a temporary file while hashing, so the digest is known before
+// uploading and the source reader is still read only once.
+//
+// Identical content always maps to the same name. A different file producing the same
+// SHA-256 digest is assumed impossible in practice (a collision is computationally
+// infeasible), so an existing object is trusted without comparing its bytes.
+//
+// Parameters:
+//   - bucketName: Target bucket name
+//   - prefix: Folder path for content-addressed objects
+//   - reader: Data source (io.Reader)
+//   - size: Total size of data in bytes (-1 if unknown); only this many bytes are read,
+//     and a shorter reader fails with io.ErrUnexpectedEOF
+//   - contentType: MIME type (e.g., "application/pdf")
+//
+// Returns:
+//   - objectName: Name of the stored (or already existing) object
+//   - existed: True if the upload was skipped because the object already existed
+//
+// Example:
+//
+//	objectName, existed, err := client.UploadDedup("my-bucket", "templates", src, file.Size, "application/pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) UploadDedup(bucketName string, prefix string, reader io.Reader, size int64, contentType string) (objectName string, existed bool, err error) {
+	return c.UploadDedupWithContext(c.baseContext(), bucketName, prefix, reader, size, contentType)
+}
+
+// UploadDedupWithContext stores data under its content hash with custom context.
+//
+// Example:
+//
+//	ctx := context.Background()
+//	objectName, existed, err := client.UploadDedupWithContext(ctx, "my-bucket", "templates", src, size, "application/pdf")
+func (c *Client) UploadDedupWithContext(ctx context.Context, bucketName string, prefix string, reader io.Reader, size int64, contentType string) (objectName string, existed bool, err error) {
+	tmp, err := os.CreateTemp("", "minio-dedup-*")
+	if err != nil {
+		return "", false, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	// Like PutObject, a known size reads exactly size bytes
+	if size >= 0 {
+		reader = io.LimitReader(reader, size)
+	}
+	hasher := sha256.New()
+	written, err := io.Copy(io.MultiWriter(tmp, hasher), reader)
+	if err != nil {
+		return "", false, err
+	}
+	if size >= 0 && written < size {
+		return "", false, fmt.Errorf("read %d of %d bytes: %w", written, size, io.ErrUnexpectedEOF)
+	}
+	digest := hex.EncodeToString(hasher.Sum(nil))
+
+	objectName = digest
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		objectName = prefix + "/" + digest
+	}
+
+	existed, err = c.objectExists(ctx, bucketName, objectName)
+	if err != nil || existed {
+		return objectName, existed, err
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return "", false, err
+	}
+	if _, err := c.putObject(ctx, bucketName, objectName, tmp, written, minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: map[string]string{ChecksumMetadataKey: digest},
+	}); err != nil {
+		return "", false, err
+	}
+	return objectName, false, nil
+}
+
+// VerifyObjectIntegrity checks an object against the SHA-256 digest stored in its metadata
+// (x-amz-meta-sha256, as written by WithIntegrityHash uploads, UploadWithChecksum and UploadDedup).
+//
+// Cost: the whole object is downloaded and hashed, so verification transfers the full
+// object size. Run it in background jobs or audits, not on every request.
+// The download is pinned to the stat'ed version, so a concurrent overwrite returns an
+// error instead of a false mismatch.
+//
+// Parameters:
+//   - bucketName: Bucket containing the object
+//   - objectName: Path to the object
+//
+// Returns:
+//   - valid: True if the content matches the stored digest
+//   - error: ErrNoChecksum if the object has no stored digest, or a MinIO error
+//
+// Example:
+//
+//	valid, err := client.VerifyObjectIntegrity("archive", "contracts/2024-001.pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !valid {
+//	    log.Printf("integrity check failed for contracts/2024-001.pdf")
+//	}
+func (c *Client) VerifyObjectIntegrity(bucketName string, objectName string) (bool, error) {
+	return c.VerifyObjectIntegrityWithContext(c.baseContext(), bucketName, objectName)
+}
+
+// VerifyObjectIntegrityWithContext checks an object against its stored SHA-256 digest with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	valid, err := client.VerifyObjectIntegrityWithContext(ctx, "archive", "contracts/2024-001.pdf")
+func (c *Client) VerifyObjectIntegrityWithContext(ctx context.Context, bucketName string, objectName string) (bool, error) {
+	info, err := c.statObject(ctx, bucketName, objectName)
+	if err != nil {
+		return false, err
+	}
+	expected := objectMetadata(info)[canonicalMetadataKey(ChecksumMetadataKey)]
+	if expected == "" {
+		return false, ErrNoChecksum
+	}
+
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetMatchETag(info.ETag); err != nil {
+		return false, err
+	}
+	obj, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return false, err
+	}
+	defer obj.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, obj); err != nil {
+		return false, err
+	}
+	return strings.EqualFold(hex.EncodeToString(hasher.Sum(nil)), strings.TrimSpace(expected)), nil
+}
+
+// objectExists checks whether an object exists using StatObject
+func (c *Client) objectExists(ctx context.Context, bucketName string, objectName string) (bool, error) {
+	if _, err := c.statObject(ctx, bucketName, objectName); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
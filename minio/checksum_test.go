@@ -0,0 +1,71 @@
+package minio
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 records the requests it receives; server-side copies fail with AccessDenied
+type fakeS3 struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	io.Copy(io.Discard, r.Body)
+	op := r.Method
+	if r.Header.Get("X-Amz-Copy-Source") != "" {
+		op = "COPY"
+	}
+	f.mu.Lock()
+	f.requests = append(f.requests, op+" "+r.URL.Path)
+	f.mu.Unlock()
+
+	switch op {
+	case http.MethodPut:
+		w.Header().Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+	}
+}
+
+func TestPutObjectWithDigestRemovesObjectWhenCopyFails(t *testing.T) {
+	fake := &fakeS3{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := NewMinio(strings.TrimPrefix(server.URL, "http://"), "access", "secret", false, MINIO_DEFAULT_REGION)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ops []string
+	client.OperationHook = func(op string, bucket string, object string, duration time.Duration, err error) {
+		ops = append(ops, op)
+	}
+
+	// A non-seekable reader is hashed while streaming, so the digest needs the copy
+	reader := io.MultiReader(strings.NewReader("report body"))
+	err = client.WithIntegrityHash().UploadFileWithReader("archive", "reports/a.txt", reader, int64(len("report body")), "text/plain", "")
+	if err == nil {
+		t.Fatal("upload succeeded, want the copy error")
+	}
+
+	if got := strings.Join(ops, ","); got != "upload,copy,remove" {
+		t.Errorf("operations = %s, want upload,copy,remove", got)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if last := fake.requests[len(fake.requests)-1]; last != "DELETE /archive/reports/a.txt" {
+		t.Errorf("last request = %q, want the object removed (requests: %v)", last, fake.requests)
+	}
+}
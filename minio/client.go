@@ -3,6 +3,14 @@
 package minio
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
 	"github.com/minio/minio-go/v7"
 	credentialsv7 "github.com/minio/minio-go/v7/pkg/credentials"
 )
@@ -21,6 +29,20 @@ type Client struct {
 	MinioSecretKey string        // Secret key for authentication
 	MinioSSL       bool          // Whether to use SSL/TLS for connections
 	Region         string        // AWS region for the MinIO server
+
+	// OperationHook is called after every object operation (see the Op constants).
+	// It runs synchronously on the calling goroutine, so keep it fast or dispatch
+	// asynchronously. A nil hook is skipped.
+	OperationHook func(op string, bucket string, object string, duration time.Duration, err error)
+
+	buckets   map[string]string // Bucket aliases registered with RegisterBucket
+	bucketsMu sync.RWMutex
+
+	configMu sync.RWMutex // Guards the connection fields during Reconfigure
+
+	parent        *Client         // Client shared by a WithDefaultContext view
+	defaultCtx    context.Context // Context used by methods without a ctx parameter
+	integrityHash bool            // Store a SHA-256 digest with every upload (WithIntegrityHash)
 }
 
 // NewMinio creates and initializes a new MinIO client with the provided credentials.
@@ -61,6 +83,60 @@ func NewMinio(endpoint string, access string, secret string, ssl bool, region st
 	return c, nil
 }
 
+// NewMinioFromEnv creates a MinIO client from environment variables.
+// Use NewMinio for programmatic configuration.
+//
+// Environment variables:
+//   - MINIO_ENDPOINT: MinIO server endpoint (required)
+//   - MINIO_ACCESS_KEY: Access key ID (required)
+//   - MINIO_SECRET_KEY: Secret access key (required)
+//   - MINIO_USE_SSL: "true" to use HTTPS (default: false)
+//   - MINIO_REGION: AWS region (default: MINIO_DEFAULT_REGION)
+//
+// Returns an error listing every missing required variable, or if MINIO_USE_SSL is not a boolean.
+//
+// Example:
+//
+//	client, err := minio.NewMinioFromEnv()
+//	if err != nil {
+//	    log.Fatal(err) // e.g. "missing MinIO environment variables: MINIO_ACCESS_KEY, MINIO_SECRET_KEY"
+//	}
+func NewMinioFromEnv() (*Client, error) {
+	endpoint := helper.GetENV("MINIO_ENDPOINT", "")
+	access := helper.GetENV("MINIO_ACCESS_KEY", "")
+	secret := helper.GetENV("MINIO_SECRET_KEY", "")
+
+	var missing []string
+	if endpoint == "" {
+		missing = append(missing, "MINIO_ENDPOINT")
+	}
+	if access == "" {
+		missing = append(missing, "MINIO_ACCESS_KEY")
+	}
+	if secret == "" {
+		missing = append(missing, "MINIO_SECRET_KEY")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing MinIO environment variables: %s", strings.Join(missing, ", "))
+	}
+
+	ssl := false
+	if value := strings.TrimSpace(helper.GetENV("MINIO_USE_SSL", "")); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MINIO_USE_SSL value %q: must be true or false", value)
+		}
+		ssl = parsed
+	}
+
+	region := helper.GetENV("MINIO_REGION", "")
+	if region == "" {
+		region = MINIO_DEFAULT_REGION
+	}
+
+	return NewMinio(endpoint, access, secret, ssl, region)
+}
+
 // GetMinioURI constructs and returns the full URI for the MinIO server.
 // Returns HTTP or HTTPS URI based on SSL configuration.
 //
@@ -68,6 +144,12 @@ func NewMinio(endpoint string, access string, secret string, ssl bool, region st
 //
 //	uri := client.GetMinioURI()  // Returns "https://localhost:9000" or "http://localhost:9000"
 func (c *Client) GetMinioURI() string {
+	if c.parent != nil {
+		return c.parent.GetMinioURI()
+	}
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
 	var minioEndURI string
 	if c.MinioSSL {
 		minioEndURI = "https://"
@@ -86,6 +168,11 @@ func (c *Client) GetMinioURI() string {
 //	minioClient := client.GetClient()
 //	// Use minioClient for advanced operations
 func (c *Client) GetClient() *minio.Client {
+	if c.parent != nil {
+		return c.parent.GetClient()
+	}
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
 	return c.MinioClient
 }
 
@@ -95,5 +182,68 @@ func (c *Client) GetClient() *minio.Client {
 //
 //	endpoint := client.GetEndPoint()  // Returns "localhost:9000"
 func (c *Client) GetEndPoint() string {
+	if c.parent != nil {
+		return c.parent.GetEndPoint()
+	}
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
 	return c.MinioEndPoint
 }
+
+// Reconfigure switches the client to a new endpoint or credentials while it is in use,
+// e.g. for credential rotation or a blue/green storage migration.
+//
+// The new connection is verified (ListBuckets; an AccessDenied answer counts as reachable
+// with valid credentials) before it is swapped in. On failure the current connection is
+// kept and the error is returned. Operations already in progress finish on the previous
+// connection; new operations use the new one. Read the connection through GetClient and
+// GetEndPoint rather than the struct fields while reconfiguring.
+//
+// Parameters:
+//   - endpoint: MinIO server endpoint (e.g., "storage-green:9000")
+//   - access: Access key ID for authentication
+//   - secret: Secret access key for authentication
+//   - ssl: Whether to use HTTPS (true) or HTTP (false)
+//   - region: AWS region (use MINIO_DEFAULT_REGION if empty)
+//
+// Example:
+//
+//	if err := client.Reconfigure("storage-green:9000", newAccess, newSecret, true, minio.MINIO_DEFAULT_REGION); err != nil {
+//	    log.Printf("switchover failed, still using %s: %v", client.GetEndPoint(), err)
+//	}
+func (c *Client) Reconfigure(endpoint string, access string, secret string, ssl bool, region string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return c.ReconfigureWithContext(ctx, endpoint, access, secret, ssl, region)
+}
+
+// ReconfigureWithContext switches the client to a new endpoint or credentials with custom
+// context for the connectivity check.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	err := client.ReconfigureWithContext(ctx, endpoint, access, secret, true, "")
+func (c *Client) ReconfigureWithContext(ctx context.Context, endpoint string, access string, secret string, ssl bool, region string) error {
+	if c.parent != nil {
+		return c.parent.ReconfigureWithContext(ctx, endpoint, access, secret, ssl, region)
+	}
+	next, err := NewMinio(endpoint, access, secret, ssl, region)
+	if err != nil {
+		return err
+	}
+	if _, err := next.MinioClient.ListBuckets(ctx); err != nil && minio.ToErrorResponse(err).Code != "AccessDenied" {
+		return fmt.Errorf("cannot connect to %s: %w", endpoint, err)
+	}
+
+	c.configMu.Lock()
+	defer c.configMu.Unlock()
+	c.MinioClient = next.MinioClient
+	c.MinioEndPoint = endpoint
+	c.MinioAccessKey = access
+	c.MinioSecretKey = secret
+	c.MinioSSL = ssl
+	c.Region = region
+	return nil
+}
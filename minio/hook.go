@@ -0,0 +1,133 @@
+package minio
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// Operation names passed to Client.OperationHook
+const (
+	OpUpload   = "upload"   // PutObject and FPutObject
+	OpDownload = "download" // GetObject (duration covers the request, not reading the body)
+	OpStat     = "stat"     // StatObject
+	OpRemove   = "remove"   // RemoveObject
+	OpCopy     = "copy"     // Server-side CopyObject (bucket and object are the destination)
+	OpPresign  = "presign"  // PresignedGetObject
+)
+
+// callHook invokes OperationHook if set
+func (c *Client) callHook(op string, bucket string, object string, start time.Time, err error) {
+	if c.OperationHook != nil {
+		c.OperationHook(op, bucket, object, time.Since(start), err)
+	}
+}
+
+// putObject calls PutObject and reports it to OperationHook.
+// Names with ".." segments or control characters are rejected with ErrInvalidObjectName.
+// On a WithIntegrityHash view the SHA-256 digest of the data is stored as well.
+func (c *Client) putObject(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	if err := checkObjectName(objectName); err != nil {
+		return minio.UploadInfo{}, err
+	}
+	if c.integrityHash && !hasChecksum(opts) {
+		info, _, err := c.putObjectWithDigest(ctx, bucketName, objectName, reader, size, opts)
+		return info, err
+	}
+	return c.putObjectRaw(ctx, bucketName, objectName, reader, size, opts)
+}
+
+// putObjectRaw calls PutObject and reports it to OperationHook, without name checks or hashing
+func (c *Client) putObjectRaw(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	if c.OperationHook == nil {
+		return c.GetClient().PutObject(ctx, bucketName, objectName, reader, size, opts)
+	}
+	start := time.Now()
+	info, err := c.GetClient().PutObject(ctx, bucketName, objectName, reader, size, opts)
+	c.callHook(OpUpload, bucketName, objectName, start, err)
+	return info, err
+}
+
+// fPutObject calls FPutObject and reports it to OperationHook.
+// Names are checked as in putObject.
+func (c *Client) fPutObject(ctx context.Context, bucketName string, objectName string, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	if err := checkObjectName(objectName); err != nil {
+		return minio.UploadInfo{}, err
+	}
+	if c.integrityHash && !hasChecksum(opts) {
+		digest, err := fileSHA256(filePath)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		opts.UserMetadata = withChecksum(opts.UserMetadata, digest)
+	}
+	if c.OperationHook == nil {
+		return c.GetClient().FPutObject(ctx, bucketName, objectName, filePath, opts)
+	}
+	start := time.Now()
+	info, err := c.GetClient().FPutObject(ctx, bucketName, objectName, filePath, opts)
+	c.callHook(OpUpload, bucketName, objectName, start, err)
+	return info, err
+}
+
+// getObject calls GetObject and reports it to OperationHook.
+// The request is sent immediately (via Stat) so that errors such as NoSuchKey surface here.
+func (c *Client) getObject(ctx context.Context, bucketName string, objectName string, opts minio.GetObjectOptions) (*minio.Object, error) {
+	start := time.Now()
+	obj, err := c.GetClient().GetObject(ctx, bucketName, objectName, opts)
+	if err == nil {
+		if _, err = obj.Stat(); err != nil {
+			obj.Close()
+			obj = nil
+		}
+	}
+	c.callHook(OpDownload, bucketName, objectName, start, err)
+	return obj, err
+}
+
+// statObject calls StatObject and reports it to OperationHook
+func (c *Client) statObject(ctx context.Context, bucketName string, objectName string) (minio.ObjectInfo, error) {
+	if c.OperationHook == nil {
+		return c.GetClient().StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	}
+	start := time.Now()
+	info, err := c.GetClient().StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	c.callHook(OpStat, bucketName, objectName, start, err)
+	return info, err
+}
+
+// removeObject calls RemoveObject and reports it to OperationHook
+func (c *Client) removeObject(ctx context.Context, bucketName string, objectName string) error {
+	if c.OperationHook == nil {
+		return c.GetClient().RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
+	}
+	start := time.Now()
+	err := c.GetClient().RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
+	c.callHook(OpRemove, bucketName, objectName, start, err)
+	return err
+}
+
+// copyObject calls CopyObject and reports it to OperationHook
+func (c *Client) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	if c.OperationHook == nil {
+		return c.GetClient().CopyObject(ctx, dst, src)
+	}
+	start := time.Now()
+	info, err := c.GetClient().CopyObject(ctx, dst, src)
+	c.callHook(OpCopy, dst.Bucket, dst.Object, start, err)
+	return info, err
+}
+
+// presignedGetObject calls PresignedGetObject and reports it to OperationHook
+func (c *Client) presignedGetObject(ctx context.Context, bucketName string, objectName string, expiry time.Duration, params url.Values) (*url.URL, error) {
+	if c.OperationHook == nil {
+		return c.GetClient().PresignedGetObject(ctx, bucketName, objectName, expiry, params)
+	}
+	start := time.Now()
+	u, err := c.GetClient().PresignedGetObject(ctx, bucketName, objectName, expiry, params)
+	c.callHook(OpPresign, bucketName, objectName, start, err)
+	return u, err
+}
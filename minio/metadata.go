@@ -0,0 +1,167 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// objectHeaderKeys are the standard headers managed by GetObjectMetadata and UpdateObjectMetadata
+var objectHeaderKeys = []string{
+	"Content-Type",
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Expires",
+}
+
+// GetObjectMetadata returns an object's standard headers (Content-Type, Cache-Control,
+// Content-Disposition, Content-Encoding, Content-Language, Expires) and user metadata
+// (x-amz-meta-*, without the prefix) in one map.
+//
+// Parameters:
+//   - bucketName: Bucket containing the object
+//   - objectName: Path to the object
+//
+// Example:
+//
+//	metadata, err := client.GetObjectMetadata("my-bucket", "reports/2024.pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(metadata["Cache-Control"], metadata["Sha256"])
+func (c *Client) GetObjectMetadata(bucketName string, objectName string) (map[string]string, error) {
+	return c.GetObjectMetadataWithContext(c.baseContext(), bucketName, objectName)
+}
+
+// GetObjectMetadataWithContext returns an object's headers and user metadata with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	metadata, err := client.GetObjectMetadataWithContext(ctx, "my-bucket", "reports/2024.pdf")
+func (c *Client) GetObjectMetadataWithContext(ctx context.Context, bucketName string, objectName string) (map[string]string, error) {
+	info, err := c.statObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return objectMetadata(info), nil
+}
+
+// UpdateObjectMetadata changes an object's headers or user metadata without re-uploading it.
+//
+// The object is copied onto itself on the server with the REPLACE metadata directive, so
+// no data goes through the client (single copy requests are limited to 5GB objects).
+// The given keys are merged into the existing metadata: content type and other values
+// are preserved unless overridden, and an empty value removes a key.
+// Standard header keys (e.g. "Cache-Control") are matched case-insensitively; any other
+// key is stored as user metadata (x-amz-meta-{key}).
+//
+// Parameters:
+//   - bucketName: Bucket containing the object
+//   - objectName: Path to the object
+//   - metadata: Keys to set (letters, digits, '-' and '_' only)
+//
+// Example:
+//
+//	err := client.UpdateObjectMetadata("my-bucket", "reports/2024.pdf", map[string]string{
+//	    "Cache-Control":       "public, max-age=86400",
+//	    "Content-Disposition": `attachment; filename="report-2024.pdf"`,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) UpdateObjectMetadata(bucketName string, objectName string, metadata map[string]string) error {
+	return c.UpdateObjectMetadataWithContext(c.baseContext(), bucketName, objectName, metadata)
+}
+
+// UpdateObjectMetadataWithContext changes an object's headers or user metadata with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err := client.UpdateObjectMetadataWithContext(ctx, "my-bucket", "logo.png", map[string]string{
+//	    "Cache-Control": "no-cache",
+//	})
+func (c *Client) UpdateObjectMetadataWithContext(ctx context.Context, bucketName string, objectName string, metadata map[string]string) error {
+	for key, value := range metadata {
+		if err := validateMetadataEntry(key, value); err != nil {
+			return err
+		}
+	}
+
+	info, err := c.statObject(ctx, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+
+	merged := objectMetadata(info)
+	for key, value := range metadata {
+		key = canonicalMetadataKey(key)
+		if value == "" {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+
+	_, err = c.copyObject(ctx, minio.CopyDestOptions{
+		Bucket:          bucketName,
+		Object:          objectName,
+		UserMetadata:    merged,
+		ReplaceMetadata: true,
+	}, minio.CopySrcOptions{
+		Bucket:    bucketName,
+		Object:    objectName,
+		MatchETag: info.ETag,
+	})
+	return err
+}
+
+// objectMetadata collects standard headers and user metadata from object info
+func objectMetadata(info minio.ObjectInfo) map[string]string {
+	result := make(map[string]string, len(info.UserMetadata)+len(objectHeaderKeys))
+	for key, value := range info.UserMetadata {
+		result[http.CanonicalHeaderKey(key)] = value
+	}
+	for _, key := range objectHeaderKeys {
+		if value := info.Metadata.Get(key); value != "" {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// canonicalMetadataKey strips the x-amz-meta- prefix and canonicalizes the key
+func canonicalMetadataKey(key string) string {
+	if strings.HasPrefix(strings.ToLower(key), "x-amz-meta-") {
+		key = key[len("x-amz-meta-"):]
+	}
+	return http.CanonicalHeaderKey(key)
+}
+
+// validateMetadataEntry checks that a metadata key and value are safe to send as headers
+func validateMetadataEntry(key string, value string) error {
+	if key == "" {
+		return fmt.Errorf("metadata key must not be empty")
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return fmt.Errorf("invalid metadata key %q", key)
+		}
+	}
+	lower := strings.ToLower(key)
+	if strings.HasPrefix(lower, "x-amz-") && !strings.HasPrefix(lower, "x-amz-meta-") {
+		return fmt.Errorf("metadata key %q is reserved", key)
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("metadata value for %q must not contain line breaks", key)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package minio
+
+import (
+	"context"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// AbortIncompleteUploads aborts incomplete multipart uploads older than a threshold.
+// Uploads canceled mid-stream (e.g. by a canceled context) leave their parts stored
+// until the multipart upload is aborted; run this periodically to reclaim that storage.
+//
+// Only uploads initiated more than olderThan ago are aborted, so uploads still in
+// progress are left alone as long as the threshold is longer than the slowest upload.
+//
+// Parameters:
+//   - bucketName: Bucket to clean up
+//   - prefix: Object name prefix to limit the cleanup ("" for the whole bucket)
+//   - olderThan: Minimum age of an incomplete upload before it is aborted
+//
+// Returns the number of aborted uploads.
+//
+// Example:
+//
+//	aborted, err := client.AbortIncompleteUploads("my-bucket", "uploads/", 24*time.Hour)
+//	if err != nil {
+//	    log.Println(err)
+//	}
+//	log.Printf("aborted %d incomplete uploads", aborted)
+func (c *Client) AbortIncompleteUploads(bucketName string, prefix string, olderThan time.Duration) (int, error) {
+	return c.AbortIncompleteUploadsWithContext(c.baseContext(), bucketName, prefix, olderThan)
+}
+
+// AbortIncompleteUploadsWithContext aborts incomplete multipart uploads with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	aborted, err := client.AbortIncompleteUploadsWithContext(ctx, "my-bucket", "", 24*time.Hour)
+func (c *Client) AbortIncompleteUploadsWithContext(ctx context.Context, bucketName string, prefix string, olderThan time.Duration) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	core := minio.Core{Client: c.GetClient()}
+	cutoff := time.Now().Add(-olderThan)
+	aborted := 0
+
+	for upload := range c.GetClient().ListIncompleteUploads(ctx, bucketName, prefix, true) {
+		if upload.Err != nil {
+			return aborted, upload.Err
+		}
+		if !upload.Initiated.Before(cutoff) {
+			continue
+		}
+		if err := core.AbortMultipartUpload(ctx, bucketName, upload.Key, upload.UploadID); err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+				continue
+			}
+			return aborted, err
+		}
+		aborted++
+	}
+	return aborted, ctx.Err()
+}
@@ -0,0 +1,108 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// Common bucket notification event names
+const (
+	EventObjectCreated = "s3:ObjectCreated:*"
+	EventObjectRemoved = "s3:ObjectRemoved:*"
+)
+
+// NotificationRecord is a single bucket event delivered by ListenBucketNotifications.
+// When Err is set the record carries no event: the stream reported an error.
+type NotificationRecord struct {
+	EventName   string    // Event type, e.g. "s3:ObjectCreated:Put"
+	EventTime   time.Time // Time of the event (zero if not provided)
+	Bucket      string    // Bucket name
+	Key         string    // Object name (URL-decoded)
+	Size        int64     // Object size in bytes (0 for removals)
+	ETag        string    // Object ETag
+	ContentType string    // Object content type
+	Err         error     // Error reported by the notification stream
+}
+
+// ListenBucketNotifications streams bucket events matching prefix, suffix and event names.
+// The channel is closed when ctx is canceled or the connection ends; cancel ctx to stop.
+// Errors from the stream are delivered as records with Err set.
+//
+// Parameters:
+//   - ctx: Context controlling the subscription lifetime
+//   - bucketName: Bucket to watch
+//   - prefix, suffix: Object name filters (empty matches all)
+//   - events: Event names, e.g. minio.EventObjectCreated (default: EventObjectCreated)
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	records, err := client.ListenBucketNotifications(ctx, "uploads", "images/", ".jpg", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for record := range records {
+//	    if record.Err != nil {
+//	        log.Println(record.Err)
+//	        continue
+//	    }
+//	    go generateThumbnail(record.Bucket, record.Key)
+//	}
+func (c *Client) ListenBucketNotifications(ctx context.Context, bucketName string, prefix string, suffix string, events []string) (<-chan NotificationRecord, error) {
+	if bucketName == "" {
+		return nil, errors.New("bucket name is required")
+	}
+	if len(events) == 0 {
+		events = []string{EventObjectCreated}
+	}
+
+	infoCh := c.GetClient().ListenBucketNotification(ctx, bucketName, prefix, suffix, events)
+	records := make(chan NotificationRecord)
+
+	go func() {
+		defer close(records)
+		for info := range infoCh {
+			if info.Err != nil {
+				if !sendRecord(ctx, records, NotificationRecord{Bucket: bucketName, Err: info.Err}) {
+					return
+				}
+				continue
+			}
+			for _, event := range info.Records {
+				key, err := url.QueryUnescape(event.S3.Object.Key)
+				if err != nil {
+					key = event.S3.Object.Key
+				}
+				eventTime, _ := time.Parse(time.RFC3339, event.EventTime)
+
+				record := NotificationRecord{
+					EventName:   event.EventName,
+					EventTime:   eventTime,
+					Bucket:      event.S3.Bucket.Name,
+					Key:         key,
+					Size:        event.S3.Object.Size,
+					ETag:        event.S3.Object.ETag,
+					ContentType: event.S3.Object.ContentType,
+				}
+				if !sendRecord(ctx, records, record) {
+					return
+				}
+			}
+		}
+	}()
+
+	return records, nil
+}
+
+// sendRecord delivers record unless ctx is done first
+func sendRecord(ctx context.Context, records chan<- NotificationRecord, record NotificationRecord) bool {
+	select {
+	case records <- record:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -8,20 +8,20 @@ import (
 	"regexp"
 	"strings"
 	"time"
-
-	"github.com/minio/minio-go/v7"
 )
 
 // generateObjectName generates a unique object name with timestamp and random number.
 // Format: {foldername}/{YYYYMMDD}_{id}_{random}.{extension}
 //
-// Internal helper function used by GenerateObjectName methods.
+// Internal helper function used by GenerateObjectName methods. The folder, id and
+// extension are sanitized, so caller input cannot produce ".." segments.
 func generateObjectName(foldername string, id string, extension string) string {
 	date := time.Now().Format("20060102")
 	generateNumber := fmt.Sprintf("%010d", rand.Intn(10000000000))
-	extension = strings.TrimPrefix(extension, ".")
+	id = cleanObjectSegment(id)
+	extension = cleanObjectSegment(strings.TrimPrefix(extension, "."))
 
-	if foldername != "" && !strings.HasSuffix(foldername, "/") {
+	if foldername = cleanObjectFolder(foldername); foldername != "" {
 		foldername += "/"
 	}
 
@@ -110,7 +110,7 @@ func (c *Client) GetObjectnameFromURL(link string) (string, string) {
 //	    log.Fatal(err)
 //	}
 func (c *Client) RemoveObject(bucketName string, objectName string) error {
-	if err := c.GetClient().RemoveObject(context.Background(), bucketName, objectName, minio.RemoveObjectOptions{}); err != nil {
+	if err := c.removeObject(c.baseContext(), bucketName, objectName); err != nil {
 		return err
 	}
 	return nil
@@ -129,8 +129,58 @@ func (c *Client) RemoveObject(bucketName string, objectName string) error {
 //	defer cancel()
 //	err := client.RemoveObjectWithContext(ctx, "my-bucket", "uploads/file.jpg")
 func (c *Client) RemoveObjectWithContext(ctx context.Context, bucketName string, objectName string) error {
-	if err := c.GetClient().RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{}); err != nil {
+	if err := c.removeObject(ctx, bucketName, objectName); err != nil {
 		return err
 	}
 	return nil
 }
+
+// GenerateContentAddressedName generates a deterministic object name from a content hash,
+// sharded into two levels of two-character directories to keep listings small.
+// Format: {foldername}/{hash[0:2]}/{hash[2:4]}/{hash}.{extension}
+//
+// The same content always maps to the same name, so there is no collision risk as with
+// the random GenerateObjectName. Use GenerateContentAddressedNameWithDepth for other depths.
+//
+// Parameters:
+//   - foldername: Folder path (may be empty)
+//   - contentHash: Hex digest of the content (e.g., SHA-256), lowercased
+//   - extension: File extension (with or without dot, may be empty)
+//
+// Example:
+//
+//	objectName := minio.GenerateContentAddressedName("files", digest, ".pdf")
+//	// Returns: "files/ab/cd/abcdef0123...pdf"
+func GenerateContentAddressedName(foldername string, contentHash string, extension string) string {
+	return GenerateContentAddressedNameWithDepth(foldername, contentHash, extension, 2)
+}
+
+// GenerateContentAddressedNameWithDepth generates a content-addressed object name with
+// the given sharding depth (number of two-character directory levels, 0 for none).
+// The depth is capped so that every shard is taken from the hash.
+//
+// Example:
+//
+//	objectName := minio.GenerateContentAddressedNameWithDepth("files", "abcdef0123", "jpg", 3)
+//	// Returns: "files/ab/cd/ef/abcdef0123.jpg"
+func GenerateContentAddressedNameWithDepth(foldername string, contentHash string, extension string, depth int) string {
+	contentHash = cleanObjectSegment(strings.ToLower(strings.TrimSpace(contentHash)))
+	// Hashes are hex; a dot could only form "." or ".." shards
+	contentHash = strings.ReplaceAll(contentHash, ".", "_")
+
+	parts := make([]string, 0, depth+2)
+	if foldername = cleanObjectFolder(foldername); foldername != "" {
+		parts = append(parts, foldername)
+	}
+	for i := 0; i < depth && (i+1)*2 <= len(contentHash); i++ {
+		parts = append(parts, contentHash[i*2:(i+1)*2])
+	}
+
+	name := contentHash
+	if extension = cleanObjectSegment(strings.TrimPrefix(extension, ".")); extension != "" {
+		name += "." + extension
+	}
+	parts = append(parts, name)
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,69 @@
+package minio
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WithDefaultContext returns a view of the client whose methods without a ctx parameter
+// use ctx instead of context.Background(). The view shares the connection, bucket aliases
+// and Reconfigure with c; only the default context differs. XxxWithContext methods still
+// use the context they are given.
+//
+// Example:
+//
+//	jobCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	err := client.WithDefaultContext(jobCtx).UploadFromFile("reports", "daily", "/tmp/report.csv", "report")
+func (c *Client) WithDefaultContext(ctx context.Context) *Client {
+	root := c
+	if c.parent != nil {
+		root = c.parent
+	}
+	root.configMu.RLock()
+	defer root.configMu.RUnlock()
+	return &Client{
+		MinioClient:    root.MinioClient,
+		MinioEndPoint:  root.MinioEndPoint,
+		MinioAccessKey: root.MinioAccessKey,
+		MinioSecretKey: root.MinioSecretKey,
+		MinioSSL:       root.MinioSSL,
+		Region:         root.Region,
+		OperationHook:  c.OperationHook,
+		parent:         root,
+		defaultCtx:     ctx,
+		integrityHash:  c.integrityHash,
+	}
+}
+
+// WithRequestContext returns a view of the client that uses the request context of gc by
+// default, tying storage operations started in a handler to the request: when the client
+// disconnects or the server times out the request, uploads and downloads are canceled
+// instead of running on for an abandoned request.
+//
+// Do not use it for work that must outlive the request (e.g. a background upload after
+// responding); use the client itself or WithDefaultContext(helper.DetachContext(gc)).
+//
+// Example:
+//
+//	r.POST("/upload", func(c *gin.Context) {
+//	    file, _ := c.FormFile("file")
+//	    storage := client.WithRequestContext(c)
+//	    if err := storage.UploadMultipartFile("uploads", file.Filename, file); err != nil {
+//	        helper.ErrorResponse(c, 500, "UPLOAD_FAILED", err.Error())
+//	        return
+//	    }
+//	    helper.SuccessResponse(c, 201, nil)
+//	})
+func (c *Client) WithRequestContext(gc *gin.Context) *Client {
+	return c.WithDefaultContext(gc.Request.Context())
+}
+
+// baseContext returns the default context of methods without a ctx parameter
+func (c *Client) baseContext() context.Context {
+	if c.defaultCtx != nil {
+		return c.defaultCtx
+	}
+	return context.Background()
+}
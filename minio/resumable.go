@@ -0,0 +1,172 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// Part sizes for UploadResumable
+const (
+	DefaultResumablePartSize int64 = 16 << 20 // 16 MiB
+	minResumablePartSize     int64 = 5 << 20  // S3 minimum for all but the last part
+	maxResumableParts        int64 = 10000    // S3 maximum number of parts
+)
+
+// ErrUploadStateMismatch is returned when an UploadState belongs to another bucket, object or size
+var ErrUploadStateMismatch = errors.New("upload state does not match this upload")
+
+// UploadState records the progress of a resumable upload. It is JSON-serializable:
+// persist it after a failed UploadResumable call and pass it back to resume.
+// Start a new upload with a zero value (optionally setting PartSize and ContentType).
+type UploadState struct {
+	Bucket      string         `json:"bucket"`
+	Object      string         `json:"object"`
+	UploadID    string         `json:"upload_id"`
+	Size        int64          `json:"size"`
+	PartSize    int64          `json:"part_size"`              // Default DefaultResumablePartSize, min 5 MiB
+	ContentType string         `json:"content_type,omitempty"` // Used when the upload is started
+	Parts       []UploadedPart `json:"parts"`
+	Completed   bool           `json:"completed"`
+}
+
+// UploadedPart is a part stored by UploadResumable
+type UploadedPart struct {
+	PartNumber int    `json:"part_number"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+}
+
+// UploadResumable uploads data as a multipart upload that can resume after a failure.
+// Every completed part is recorded in state, so calling again with the same state skips
+// the parts already stored and uploads only the rest.
+//
+// Persisting state between attempts is the caller's job. If the multipart upload no longer
+// exists on the server (e.g. removed by AbortIncompleteUploads) the state is reset and an
+// error is returned; the next call starts over. A completed state returns nil immediately.
+//
+// Parameters:
+//   - bucketName: Target bucket
+//   - objectName: Object name for the upload
+//   - reader: Source data; parts are read with ReadAt so they can be re-read on resume
+//   - size: Total size in bytes
+//   - state: Upload progress (required)
+//
+// Example:
+//
+//	file, _ := os.Open("backup.tar")
+//	info, _ := file.Stat()
+//	state := loadState() // zero UploadState on the first attempt
+//	if err := client.UploadResumable("backups", "2024/backup.tar", file, info.Size(), state); err != nil {
+//	    saveState(state) // resume later with the same state
+//	    log.Fatal(err)
+//	}
+func (c *Client) UploadResumable(bucketName string, objectName string, reader io.ReaderAt, size int64, state *UploadState) error {
+	return c.UploadResumableWithContext(c.baseContext(), bucketName, objectName, reader, size, state)
+}
+
+// UploadResumableWithContext uploads data as a resumable multipart upload with custom context.
+// Canceling ctx stops after the current part; the state keeps the parts already stored.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+//	defer cancel()
+//	err := client.UploadResumableWithContext(ctx, "backups", "backup.tar", file, size, state)
+func (c *Client) UploadResumableWithContext(ctx context.Context, bucketName string, objectName string, reader io.ReaderAt, size int64, state *UploadState) (err error) {
+	if state == nil {
+		return errors.New("upload state is required")
+	}
+	if state.Completed {
+		return nil
+	}
+	if size <= 0 {
+		return errors.New("size must be greater than zero")
+	}
+	if err := checkObjectName(objectName); err != nil {
+		return err
+	}
+	if state.UploadID != "" && (state.Bucket != bucketName || state.Object != objectName || state.Size != size) {
+		return fmt.Errorf("%w: started for %s/%s (%d bytes)", ErrUploadStateMismatch, state.Bucket, state.Object, state.Size)
+	}
+
+	start := time.Now()
+	defer func() { c.callHook(OpUpload, bucketName, objectName, start, err) }()
+
+	core := minio.Core{Client: c.GetClient()}
+	if state.UploadID == "" {
+		uploadID, err := core.NewMultipartUpload(ctx, bucketName, objectName, minio.PutObjectOptions{ContentType: state.ContentType})
+		if err != nil {
+			return err
+		}
+		state.Bucket = bucketName
+		state.Object = objectName
+		state.Size = size
+		state.PartSize = resumablePartSize(size, state.PartSize)
+		state.UploadID = uploadID
+		state.Parts = nil
+	}
+
+	done := make(map[int]bool, len(state.Parts))
+	for _, part := range state.Parts {
+		done[part.PartNumber] = true
+	}
+
+	partCount := int((size + state.PartSize - 1) / state.PartSize)
+	for number := 1; number <= partCount; number++ {
+		if done[number] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		offset := int64(number-1) * state.PartSize
+		length := min(state.PartSize, size-offset)
+		part, err := core.PutObjectPart(ctx, bucketName, objectName, state.UploadID, number,
+			io.NewSectionReader(reader, offset, length), length, minio.PutObjectPartOptions{})
+		if err != nil {
+			return resetMissingUpload(state, err)
+		}
+		state.Parts = append(state.Parts, UploadedPart{PartNumber: number, ETag: part.ETag, Size: length})
+	}
+
+	sort.Slice(state.Parts, func(i, j int) bool { return state.Parts[i].PartNumber < state.Parts[j].PartNumber })
+	completeParts := make([]minio.CompletePart, len(state.Parts))
+	for i, part := range state.Parts {
+		completeParts[i] = minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag}
+	}
+	if _, err := core.CompleteMultipartUpload(ctx, bucketName, objectName, state.UploadID, completeParts, minio.PutObjectOptions{ContentType: state.ContentType}); err != nil {
+		return resetMissingUpload(state, err)
+	}
+
+	state.Completed = true
+	return nil
+}
+
+// resumablePartSize returns a part size of at least 5 MiB that fits size in 10000 parts
+func resumablePartSize(size int64, partSize int64) int64 {
+	if partSize <= 0 {
+		partSize = DefaultResumablePartSize
+	}
+	partSize = max(partSize, minResumablePartSize)
+	if minSize := (size + maxResumableParts - 1) / maxResumableParts; partSize < minSize {
+		partSize = minSize
+	}
+	return partSize
+}
+
+// resetMissingUpload clears state when the server no longer knows the upload
+func resetMissingUpload(state *UploadState, err error) error {
+	if minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return err
+	}
+	state.UploadID = ""
+	state.Parts = nil
+	return fmt.Errorf("multipart upload no longer exists, state was reset: %w", err)
+}
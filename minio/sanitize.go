@@ -0,0 +1,102 @@
+package minio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidObjectName is returned for object names that are empty, contain control
+// characters or could traverse out of their folder with ".." segments
+var ErrInvalidObjectName = errors.New("invalid object name")
+
+// SanitizeObjectName cleans a caller-provided object name before it is used as a key.
+//
+// Control characters are removed, backslashes become slashes, duplicate slashes are
+// collapsed, "." segments and leading/trailing slashes are dropped. Names containing a
+// ".." segment, or that are empty after cleaning, return ErrInvalidObjectName.
+//
+// Example:
+//
+//	name, err := minio.SanitizeObjectName("/uploads//2024/./report.pdf") // "uploads/2024/report.pdf"
+//	name, err = minio.SanitizeObjectName("uploads/../secrets/key.pem")    // ErrInvalidObjectName
+func SanitizeObjectName(name string) (string, error) {
+	segments := splitObjectPath(name)
+	for _, segment := range segments {
+		if segment == ".." {
+			return "", fmt.Errorf("%w: %q contains a \"..\" segment", ErrInvalidObjectName, name)
+		}
+	}
+	if len(segments) == 0 {
+		return "", fmt.Errorf("%w: %q is empty", ErrInvalidObjectName, name)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+// checkObjectName rejects object names with ".." segments or control characters
+// before an upload. It does not rewrite the name, so existing keys stay addressable.
+func checkObjectName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is empty", ErrInvalidObjectName)
+	}
+	if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return fmt.Errorf("%w: %q contains control characters", ErrInvalidObjectName, name)
+	}
+	for _, segment := range strings.FieldsFunc(name, isObjectPathSeparator) {
+		if segment == ".." {
+			return fmt.Errorf("%w: %q contains a \"..\" segment", ErrInvalidObjectName, name)
+		}
+	}
+	return nil
+}
+
+// cleanObjectFolder sanitizes a folder for the name generators, dropping ".." segments
+func cleanObjectFolder(foldername string) string {
+	segments := splitObjectPath(foldername)
+	kept := segments[:0]
+	for _, segment := range segments {
+		if segment != ".." {
+			kept = append(kept, segment)
+		}
+	}
+	return strings.Join(kept, "/")
+}
+
+// cleanObjectSegment sanitizes a value used inside a single path segment (id, extension):
+// control characters are removed and separators replaced with "_"
+func cleanObjectSegment(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		if isObjectPathSeparator(r) {
+			return '_'
+		}
+		return r
+	}, value)
+}
+
+// splitObjectPath removes control characters and returns the non-empty, non-"." segments
+func splitObjectPath(name string) []string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+
+	segments := strings.FieldsFunc(name, isObjectPathSeparator)
+	kept := segments[:0]
+	for _, segment := range segments {
+		if segment != "." {
+			kept = append(kept, segment)
+		}
+	}
+	return kept
+}
+
+// isObjectPathSeparator matches slashes and backslashes
+func isObjectPathSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeObjectName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"clean", "uploads/2024/report.pdf", "uploads/2024/report.pdf", false},
+		{"duplicate and edge slashes", "/uploads//2024/./report.pdf/", "uploads/2024/report.pdf", false},
+		{"backslashes", `uploads\2024\report.pdf`, "uploads/2024/report.pdf", false},
+		{"control characters", "uploads/re\x00port\n.pdf", "uploads/report.pdf", false},
+		{"dots inside names", "uploads/v1..2/a..b.txt", "uploads/v1..2/a..b.txt", false},
+		{"traversal", "uploads/../secrets/key.pem", "", true},
+		{"leading traversal", "../../etc/passwd", "", true},
+		{"backslash traversal", `uploads\..\..\other`, "", true},
+		{"control-hidden traversal", "uploads/.\x00./other", "", true},
+		{"empty", "", "", true},
+		{"only separators", "//./", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SanitizeObjectName(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidObjectName) {
+					t.Errorf("SanitizeObjectName(%q) error = %v, want ErrInvalidObjectName", tt.input, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("SanitizeObjectName(%q) = %q, %v; want %q", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckObjectName(t *testing.T) {
+	for _, name := range []string{"uploads/report.pdf", "uploads//legacy/key", "a..b"} {
+		if err := checkObjectName(name); err != nil {
+			t.Errorf("checkObjectName(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"", "uploads/../other", `..\other`, "uploads/a\nb", "uploads/\x7f"} {
+		if err := checkObjectName(name); !errors.Is(err, ErrInvalidObjectName) {
+			t.Errorf("checkObjectName(%q) = %v, want ErrInvalidObjectName", name, err)
+		}
+	}
+}
+
+func TestGeneratedNamesCannotTraverse(t *testing.T) {
+	names := []string{
+		GenerateObjectName("uploads/../../other", "../../etc", "../jpg"),
+		GenerateObjectName(`\uploads\..\`, "a/b\\c\n", ".png"),
+		GenerateContentAddressedName("../files", "../ABCDEF", "../pdf"),
+	}
+	for _, name := range names {
+		if err := checkObjectName(name); err != nil {
+			t.Errorf("generated %q: %v", name, err)
+		}
+		if strings.HasPrefix(name, "/") || strings.Contains(name, "//") {
+			t.Errorf("generated %q has empty segments", name)
+		}
+	}
+	if name := GenerateObjectName("uploads/../../other", "user1", "jpg"); !strings.HasPrefix(name, "uploads/other/") {
+		t.Errorf("folder ../ segments must be dropped: %q", name)
+	}
+}
+
+func TestUploadRejectsTraversalBeforeSending(t *testing.T) {
+	// No MinIO connection: the name is rejected before any request is made
+	c := &Client{}
+	err := c.UploadFileWithReaderWithContext(context.Background(), "bucket", "uploads/../secrets", strings.NewReader("x"), 1, "text/plain", "")
+	if !errors.Is(err, ErrInvalidObjectName) {
+		t.Errorf("upload error = %v, want ErrInvalidObjectName", err)
+	}
+	if _, err := c.UploadWithChecksumWithContext(context.Background(), "bucket", "a/\r\nb", strings.NewReader("x"), 1, "text/plain"); !errors.Is(err, ErrInvalidObjectName) {
+		t.Errorf("checksum upload error = %v, want ErrInvalidObjectName", err)
+	}
+}
@@ -0,0 +1,76 @@
+package minio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidTenantID is returned for tenant IDs that are empty or not a single path segment
+var ErrInvalidTenantID = errors.New("invalid tenant id")
+
+// ErrObjectKeyOutsideTenant is returned when an object key does not belong to the tenant
+var ErrObjectKeyOutsideTenant = errors.New("object key does not belong to tenant")
+
+// GenerateTenantObjectName generates a unique object name namespaced by tenant.
+// The result is GenerateObjectName prefixed with "<tenantID>/", so every object of a
+// tenant lives under its own prefix.
+//
+// Parameters:
+//   - tenantID: Tenant identifier (must be non-empty and must not contain "/", "\" or "..")
+//   - foldername: Folder path inside the tenant prefix
+//   - id: Identifier (e.g., user ID, document ID)
+//   - extension: File extension (with or without dot)
+//
+// Returns:
+//   - objectName: Generated object name
+//   - error: ErrInvalidTenantID if the tenant ID is not path-safe
+//
+// Example:
+//
+//	objectName, err := minio.GenerateTenantObjectName("acme", "invoices", "inv42", ".pdf")
+//	// Returns: "acme/invoices/20260113_inv42_1234567890.pdf"
+func GenerateTenantObjectName(tenantID string, foldername string, id string, extension string) (string, error) {
+	if err := validateTenantID(tenantID); err != nil {
+		return "", err
+	}
+	return generateObjectName(tenantID+"/"+strings.TrimPrefix(foldername, "/"), id, extension), nil
+}
+
+// ValidateObjectKeyForTenant checks that an incoming object key belongs to the tenant
+// before it is downloaded or deleted. The key must start with "<tenantID>/" and must not
+// contain ".." segments, backslashes or a leading slash.
+//
+// Example:
+//
+//	if err := minio.ValidateObjectKeyForTenant(c.Query("key"), tenantID); err != nil {
+//	    helper.ErrorResponse(c, 403, "FORBIDDEN", "Access to this object is not allowed")
+//	    return
+//	}
+func ValidateObjectKeyForTenant(key string, tenantID string) error {
+	if err := validateTenantID(tenantID); err != nil {
+		return err
+	}
+	if strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("%w: %s", ErrObjectKeyOutsideTenant, key)
+	}
+	rest, ok := strings.CutPrefix(key, tenantID+"/")
+	if !ok || rest == "" {
+		return fmt.Errorf("%w: %s", ErrObjectKeyOutsideTenant, key)
+	}
+	for _, segment := range strings.Split(rest, "/") {
+		if segment == ".." || segment == "." {
+			return fmt.Errorf("%w: %s", ErrObjectKeyOutsideTenant, key)
+		}
+	}
+	return nil
+}
+
+// validateTenantID rejects tenant IDs that are empty or could escape their prefix
+func validateTenantID(tenantID string) error {
+	if strings.TrimSpace(tenantID) == "" || tenantID == "." ||
+		strings.ContainsAny(tenantID, "/\\") || strings.Contains(tenantID, "..") {
+		return fmt.Errorf("%w: %q", ErrInvalidTenantID, tenantID)
+	}
+	return nil
+}
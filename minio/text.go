@@ -0,0 +1,53 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/minio/minio-go/v7"
+)
+
+// UploadTextWithReader uploads a text file (CSV, JSON, TXT) to MinIO.
+// With normalize enabled, a leading UTF-8 BOM is removed and CRLF line endings are
+// converted to LF before storing, so files saved on Windows parse like any other.
+//
+// The content is read into memory to compute the normalized size; use
+// UploadFileWithReader for large files.
+//
+// Parameters:
+//   - bucketName: Target bucket name
+//   - objectName: Destination object path
+//   - reader: Text data source (io.Reader)
+//   - contentType: MIME type (e.g., "text/csv")
+//   - normalize: Strip the BOM and normalize line endings
+//
+// Example:
+//
+//	src, _ := file.Open()
+//	defer src.Close()
+//	err := client.UploadTextWithReader("my-bucket", "imports/users.csv", src, "text/csv", true)
+func (c *Client) UploadTextWithReader(bucketName string, objectName string, reader io.Reader, contentType string, normalize bool) error {
+	return c.UploadTextWithReaderWithContext(c.baseContext(), bucketName, objectName, reader, contentType, normalize)
+}
+
+// UploadTextWithReaderWithContext uploads a text file with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	err := client.UploadTextWithReaderWithContext(ctx, "my-bucket", "imports/users.csv", src, "text/csv", true)
+func (c *Client) UploadTextWithReaderWithContext(ctx context.Context, bucketName string, objectName string, reader io.Reader, contentType string, normalize bool) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if normalize {
+		data = helper.NormalizeText(data)
+	}
+
+	_, err = c.putObject(ctx, bucketName, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
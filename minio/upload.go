@@ -49,7 +49,8 @@ func CreateImageFile(img image.Image, format string, filename string, desc strin
 }
 
 // UploadMultipartFile uploads a file from HTTP multipart form data to MinIO.
-// Automatically extracts content type and size from the file header.
+// Automatically extracts content type and size from the file header, and records the
+// uploaded file name for ServeObjectAsAttachment.
 //
 // Parameters:
 //   - bucketName: Target bucket name
@@ -72,7 +73,8 @@ func (c *Client) UploadMultipartFile(bucketName string, objectName string, file
 
 	defer src.Close()
 
-	if _, err = c.GetClient().PutObject(context.Background(), bucketName, objectName, src, size, minio.PutObjectOptions{ContentType: contentType}); err != nil {
+	opts := minio.PutObjectOptions{ContentType: contentType, UserMetadata: originalFilenameMetadata(file.Filename)}
+	if _, err = c.putObject(c.baseContext(), bucketName, objectName, src, size, opts); err != nil {
 		return err
 	}
 	return nil
@@ -95,7 +97,8 @@ func (c *Client) UploadMultipartFileWithContext(ctx context.Context, bucketName
 
 	defer src.Close()
 
-	if _, err = c.GetClient().PutObject(ctx, bucketName, objectName, src, size, minio.PutObjectOptions{ContentType: contentType}); err != nil {
+	opts := minio.PutObjectOptions{ContentType: contentType, UserMetadata: originalFilenameMetadata(file.Filename)}
+	if _, err = c.putObject(ctx, bucketName, objectName, src, size, opts); err != nil {
 		return err
 	}
 	return nil
@@ -117,7 +120,7 @@ func (c *Client) UploadMultipartFileWithContext(ctx context.Context, bucketName
 //	data := bytes.NewReader([]byte("file content"))
 //	err := client.UploadFileWithReader("my-bucket", "file.txt", data, int64(len("file content")), "text/plain", "UTF-8")
 func (c *Client) UploadFileWithReader(bucketName string, objectName string, reader io.Reader, size int64, contentType string, contentEncoding string) (err error) {
-	if _, err = c.GetClient().PutObject(context.Background(), bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType, ContentEncoding: contentEncoding}); err != nil {
+	if _, err = c.putObject(c.baseContext(), bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType, ContentEncoding: contentEncoding}); err != nil {
 		return err
 	}
 	return nil
@@ -131,7 +134,7 @@ func (c *Client) UploadFileWithReader(bucketName string, objectName string, read
 //	defer cancel()
 //	err := client.UploadFileWithReaderWithContext(ctx, "my-bucket", "file.txt", reader, size, "text/plain", "UTF-8")
 func (c *Client) UploadFileWithReaderWithContext(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, contentType string, contentEncoding string) (err error) {
-	if _, err = c.GetClient().PutObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType, ContentEncoding: contentEncoding}); err != nil {
+	if _, err = c.putObject(ctx, bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType, ContentEncoding: contentEncoding}); err != nil {
 		return err
 	}
 	return nil
@@ -159,7 +162,7 @@ func (c *Client) UploadFromFile(bucketName string, foldername string, pathFile s
 
 	defer src.Close()
 
-	if _, err := c.GetClient().FPutObject(context.Background(), bucketName, objectName, pathFile, minio.PutObjectOptions{}); err != nil {
+	if _, err := c.fPutObject(c.baseContext(), bucketName, objectName, pathFile, minio.PutObjectOptions{}); err != nil {
 		return err
 	}
 	return nil
@@ -182,7 +185,7 @@ func (c *Client) UploadFromFileWithContact(ctx context.Context, bucketName strin
 
 	defer src.Close()
 
-	if _, err := c.GetClient().FPutObject(ctx, bucketName, objectName, pathFile, minio.PutObjectOptions{}); err != nil {
+	if _, err := c.fPutObject(ctx, bucketName, objectName, pathFile, minio.PutObjectOptions{}); err != nil {
 		return err
 	}
 	return nil
@@ -210,7 +213,7 @@ func (c *Client) UploadFromFilePDF(bucketName string, foldername string, pathFil
 
 	defer src.Close()
 
-	if _, err := c.GetClient().FPutObject(context.Background(), bucketName, objectName, pathFile, minio.PutObjectOptions{ContentType: "application/pdf", ContentEncoding: "UTF-8"}); err != nil {
+	if _, err := c.fPutObject(c.baseContext(), bucketName, objectName, pathFile, minio.PutObjectOptions{ContentType: "application/pdf", ContentEncoding: "UTF-8"}); err != nil {
 		return err
 	}
 	return nil
@@ -234,8 +237,59 @@ func (c *Client) UploadFromFilePDFWithContext(ctx context.Context, bucketName st
 
 	defer src.Close()
 
-	if _, err := c.GetClient().FPutObject(ctx, bucketName, objectName, pathFile, minio.PutObjectOptions{ContentType: "application/pdf", ContentEncoding: "UTF-8"}); err != nil {
+	if _, err := c.fPutObject(ctx, bucketName, objectName, pathFile, minio.PutObjectOptions{ContentType: "application/pdf", ContentEncoding: "UTF-8"}); err != nil {
 		return err
 	}
 	return nil
 }
+
+// UploadIfAbsent uploads data only if no object with the same name exists yet.
+// Returns uploaded=false (and no error) when the object already existed.
+//
+// The existing object is checked with StatObject, and the upload itself is sent with
+// "If-None-Match: *", so servers that support conditional writes also reject an object
+// created by another client between the check and the upload.
+//
+// Parameters:
+//   - bucketName: Target bucket name
+//   - objectName: Destination object path
+//   - reader: Data source (io.Reader)
+//   - size: Total size of data in bytes (-1 if unknown)
+//   - contentType: MIME type (e.g., "application/pdf")
+//
+// Example:
+//
+//	uploaded, err := client.UploadIfAbsent("my-bucket", objectName, src, file.Size, "image/jpeg")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !uploaded {
+//	    // Name collision: generate a new name and retry
+//	}
+func (c *Client) UploadIfAbsent(bucketName string, objectName string, reader io.Reader, size int64, contentType string) (uploaded bool, err error) {
+	return c.UploadIfAbsentWithContext(c.baseContext(), bucketName, objectName, reader, size, contentType)
+}
+
+// UploadIfAbsentWithContext uploads data only if the object does not exist, with custom context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	uploaded, err := client.UploadIfAbsentWithContext(ctx, "my-bucket", "file.txt", reader, size, "text/plain")
+func (c *Client) UploadIfAbsentWithContext(ctx context.Context, bucketName string, objectName string, reader io.Reader, size int64, contentType string) (uploaded bool, err error) {
+	exists, err := c.objectExists(ctx, bucketName, objectName)
+	if err != nil || exists {
+		return false, err
+	}
+
+	opts := minio.PutObjectOptions{ContentType: contentType}
+	opts.SetMatchETagExcept("*")
+	if _, err := c.putObject(ctx, bucketName, objectName, reader, size, opts); err != nil {
+		if minio.ToErrorResponse(err).Code == "PreconditionFailed" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,115 @@
+package minio
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/AECInfraconnect/go-module-helper/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+// ZipOptions configures StreamObjectsAsZipWithOptions.
+type ZipOptions struct {
+	Filename      string // Download file name (default "download.zip")
+	FailOnMissing bool   // Return an error instead of skipping objects that do not exist
+}
+
+// StreamObjectsAsZip streams several objects to the client as a single zip archive.
+// Objects are fetched one at a time and written into the archive on the fly, so the
+// archive is never buffered in memory. Missing objects are skipped.
+//
+// Parameters:
+//   - ctx: Context for the MinIO requests (usually gc.Request.Context())
+//   - gc: Gin context the archive is written to
+//   - bucketName: Bucket containing the objects
+//   - objectNames: Objects to include; each keeps its object name as path in the archive
+//
+// Example:
+//
+//	r.GET("/tickets/:id/attachments.zip", func(c *gin.Context) {
+//	    names := attachmentNames(c.Param("id"))
+//	    if err := client.StreamObjectsAsZip(c.Request.Context(), c, "attachments", names); err != nil {
+//	        log.Println(err)
+//	    }
+//	})
+func (c *Client) StreamObjectsAsZip(ctx context.Context, gc *gin.Context, bucketName string, objectNames []string) error {
+	return c.StreamObjectsAsZipWithOptions(ctx, gc, bucketName, objectNames, ZipOptions{})
+}
+
+// StreamObjectsAsZipWithOptions streams objects as a zip archive with a custom file name
+// and missing-object handling.
+//
+// All objects are checked before the response starts: with FailOnMissing a missing
+// object returns its error without writing a response, so the caller can choose the
+// error format. Errors while streaming cannot change the already-sent status; the
+// archive is cut short and the error is returned.
+//
+// Example:
+//
+//	err := client.StreamObjectsAsZipWithOptions(c.Request.Context(), c, "attachments", names, minio.ZipOptions{
+//	    Filename:      "ticket-42.zip",
+//	    FailOnMissing: true,
+//	})
+//	if err != nil && !c.Writer.Written() {
+//	    helper.ErrorResponse(c, 404, "NOT_FOUND", "Attachment not found")
+//	}
+func (c *Client) StreamObjectsAsZipWithOptions(ctx context.Context, gc *gin.Context, bucketName string, objectNames []string, opts ZipOptions) error {
+	if opts.Filename == "" {
+		opts.Filename = "download.zip"
+	}
+
+	objects := make([]minio.ObjectInfo, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		if err := checkObjectName(objectName); err != nil {
+			return err
+		}
+		info, err := c.statObject(ctx, bucketName, objectName)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchKey" && !opts.FailOnMissing {
+				continue
+			}
+			return err
+		}
+		objects = append(objects, info)
+	}
+
+	gc.Header("Content-Type", "application/zip")
+	gc.Header("Content-Disposition", helper.AttachmentDisposition(opts.Filename))
+	gc.Status(http.StatusOK)
+
+	zw := zip.NewWriter(gc.Writer)
+	for _, info := range objects {
+		if err := c.writeZipEntry(ctx, zw, bucketName, info); err != nil {
+			return err
+		}
+		gc.Writer.Flush()
+	}
+	return zw.Close()
+}
+
+// writeZipEntry copies one object into the archive, pinned to the stat'ed version
+func (c *Client) writeZipEntry(ctx context.Context, zw *zip.Writer, bucketName string, info minio.ObjectInfo) error {
+	getOpts := minio.GetObjectOptions{}
+	if err := getOpts.SetMatchETag(info.ETag); err != nil {
+		return err
+	}
+	obj, err := c.getObject(ctx, bucketName, info.Key, getOpts)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	entry, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     info.Key,
+		Method:   zip.Deflate,
+		Modified: info.LastModified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, obj)
+	return err
+}